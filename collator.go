@@ -0,0 +1,146 @@
+package jisx4061
+
+// Collator compares strings according to JIS X 4061.
+// The zero value is not ready to use; use [New] instead.
+type Collator struct {
+	strength       Strength
+	ignoreCase     bool
+	ignoreKanaType bool
+	unknownRunes   UnknownRunePolicy
+	yomi           YomiProvider
+	kanjiOrder     KanjiOrder
+	kanaOrder      KanaOrder
+	numeric        bool
+	kanjiNumerals  bool
+	foldCompat     bool
+
+	// table is the tailored part of the collation table.
+	table map[rune]attr
+
+	// subRanks reports whether the table has sub-ranks.
+	subRanks bool
+
+	// rules are the characters inserted by the tailoring rules.
+	rules *ruleLists
+}
+
+// Option configures a [Collator].
+type Option func(c *Collator)
+
+// Strength is the level of comparison.
+// Each level corresponds to an attribute defined in JIS X 4061,
+// and the collator compares the attributes in this order.
+type Strength int
+
+const (
+	// Primary compares the character classes (文字クラス) and the orders (番号).
+	Primary Strength = iota + 1
+
+	// Secondary compares the voicing (清濁).
+	Secondary
+
+	// Tertiary compares the symbol types (記号種別).
+	Tertiary
+
+	// Quaternary compares the kana types (仮名種別).
+	Quaternary
+
+	// Quinary compares the diacritical marks (ダイアクリティカルマーク).
+	Quinary
+
+	// Senary compares the letter cases (大小).
+	// It is the default strength.
+	Senary
+)
+
+// UnknownRunePolicy specifies how the collator treats runes that are not in the collation table,
+// such as emoji, Hangul and control characters.
+type UnknownRunePolicy int
+
+const (
+	// UnknownRuneIgnore ignores unknown runes, as if they were not in the string.
+	// It is the default.
+	UnknownRuneIgnore UnknownRunePolicy = iota
+
+	// UnknownRuneLast sorts unknown runes after all character classes.
+	// All unknown runes are equal to each other.
+	UnknownRuneLast
+
+	// UnknownRuneCodePoint sorts unknown runes after all character classes by their code points.
+	UnknownRuneCodePoint
+
+	// UnknownRuneReject makes [Collator.CompareErr] return an [*UnknownRuneError] for unknown runes.
+	// The methods that don't return errors, such as [Collator.Compare], ignore unknown runes.
+	UnknownRuneReject
+)
+
+// defaultCollator is used by the package level functions.
+var defaultCollator = New()
+
+// New returns a new [Collator] configured with the given options.
+// Without options, it behaves as same as the package level functions.
+func New(opts ...Option) *Collator {
+	c := &Collator{
+		strength: Senary,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// IgnoreCase makes the collator ignore the letter case (大小) of the Latin alphabet.
+func IgnoreCase() Option {
+	return func(c *Collator) {
+		c.ignoreCase = true
+	}
+}
+
+// IgnoreKanaType makes the collator ignore the kana type (仮名種別), i.e. the difference of Hiragana and Katakana.
+func IgnoreKanaType() Option {
+	return func(c *Collator) {
+		c.ignoreKanaType = true
+	}
+}
+
+// IgnoreLowerLevels makes the collator compare only the character classes (文字クラス) and the orders (番号).
+// Voicing, symbol types, kana types, diacritical marks and letter cases are ignored.
+// It is same as WithStrength(Primary).
+func IgnoreLowerLevels() Option {
+	return WithStrength(Primary)
+}
+
+// WithStrength makes the collator compare the strings up to the given level.
+// For example, WithStrength(Secondary) distinguishes "さとう" from "さどう",
+// but doesn't distinguish "さとう" from "サトウ".
+func WithStrength(s Strength) Option {
+	return func(c *Collator) {
+		c.strength = s
+	}
+}
+
+// UnknownRunes sets the policy for runes that are not in the collation table.
+func UnknownRunes(policy UnknownRunePolicy) Option {
+	return func(c *Collator) {
+		c.unknownRunes = policy
+	}
+}
+
+// hasTiebreak reports whether the collator compares the tiebreak weights after all levels.
+func (c *Collator) hasTiebreak() bool {
+	return c.yomi != nil || c.numeric || c.foldCompat
+}
+
+// ignores reports whether the collator skips the level.
+func (c *Collator) ignores(level Strength) bool {
+	if level > c.strength {
+		return true
+	}
+	switch level {
+	case Quaternary:
+		return c.ignoreKanaType
+	case Senary:
+		return c.ignoreCase
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package jisx4061
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCollator_Compare(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		a, b string
+		want int
+	}{
+		{
+			name: "default",
+			a:    "ａ",
+			b:    "Ａ",
+			want: -1,
+		},
+		{
+			name: "ignore case",
+			opts: []Option{IgnoreCase()},
+			a:    "ａ",
+			b:    "Ａ",
+			want: 0,
+		},
+		{
+			name: "ignore case keeps diacritical marks",
+			opts: []Option{IgnoreCase()},
+			a:    "a",
+			b:    "Â",
+			want: -1,
+		},
+		{
+			name: "default kana type",
+			a:    "さとう",
+			b:    "サトウ",
+			want: -1,
+		},
+		{
+			name: "ignore kana type",
+			opts: []Option{IgnoreKanaType()},
+			a:    "さとう",
+			b:    "サトウ",
+			want: 0,
+		},
+		{
+			name: "ignore kana type keeps voicing",
+			opts: []Option{IgnoreKanaType()},
+			a:    "さとう",
+			b:    "サドウ",
+			want: -1,
+		},
+		{
+			name: "ignore lower levels",
+			opts: []Option{IgnoreLowerLevels()},
+			a:    "さとう",
+			b:    "サドウ",
+			want: 0,
+		},
+		{
+			name: "ignore lower levels keeps orders",
+			opts: []Option{IgnoreLowerLevels()},
+			a:    "さとう",
+			b:    "さとお",
+			want: -1,
+		},
+		{
+			name: "ignore unknown runes",
+			a:    "a😀b",
+			b:    "ab",
+			want: 0,
+		},
+		{
+			name: "unknown runes last",
+			opts: []Option{UnknownRunes(UnknownRuneLast)},
+			a:    "a😀b",
+			b:    "ab",
+			want: 1,
+		},
+		{
+			name: "unknown runes last after geta",
+			opts: []Option{UnknownRunes(UnknownRuneLast)},
+			a:    "〓",
+			b:    "😀",
+			want: -1,
+		},
+		{
+			name: "unknown runes are equal",
+			opts: []Option{UnknownRunes(UnknownRuneLast)},
+			a:    "😀",
+			b:    "😁",
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(tt.opts...)
+			if got := c.Compare(tt.a, tt.b); got != tt.want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+			if got := c.Compare(tt.b, tt.a); got != -tt.want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+			}
+			if got := bytes.Compare(c.Key(tt.a), c.Key(tt.b)); got != tt.want {
+				t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollator_Sort(t *testing.T) {
+	c := New(IgnoreKanaType())
+	list := []string{"サトウ", "さとう", "さど", "さと"}
+	c.Stable(list)
+	want := []string{"さと", "さど", "サトウ", "さとう"}
+	for i := range want {
+		if list[i] != want[i] {
+			t.Errorf("want %v, got %v", want, list)
+			break
+		}
+	}
+	if !c.IsSorted(list) {
+		t.Errorf("want %v is sorted, but not", list)
+	}
+}
+
+func TestCompareLevel(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		level Strength
+		want  int
+	}{
+		{"さとう", "さどう", Primary, 0},
+		{"さとう", "さどう", Secondary, -1},
+		{"さとう", "サトウ", Secondary, 0},
+		{"さとう", "サトウ", Tertiary, 0},
+		{"さとう", "サトウ", Quaternary, -1},
+		{"シャイ", "シヤイ", Secondary, 0},
+		{"シャイ", "シヤイ", Tertiary, -1},
+		{"ā", "a", Quaternary, 0},
+		{"ā", "a", Quinary, 1},
+		{"a", "A", Quinary, 0},
+		{"a", "A", Senary, -1},
+		{"さとう", "さとうや", Primary, -1},
+	}
+	for _, tt := range tests {
+		if got := CompareLevel(tt.a, tt.b, tt.level); got != tt.want {
+			t.Errorf("CompareLevel(%q, %q, %d) = %d, want %d", tt.a, tt.b, tt.level, got, tt.want)
+		}
+		c := New(WithStrength(tt.level))
+		if got := bytes.Compare(c.Key(tt.a), c.Key(tt.b)); got != tt.want {
+			t.Errorf("bytes.Compare(Key(%q), Key(%q)) at level %d = %d, want %d", tt.a, tt.b, tt.level, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package jisx4061
+
+// FoldCompatibility makes the collator replace compatibility characters with their NFKC forms before the comparison,
+// for example, ㈱ with (株), ㌔ with キロ, ㍻ with 平成 and ﬁ with fi.
+// The strings that differ only in the folded characters are ordered by the original characters after all levels,
+// and the unfolded form comes first.
+func FoldCompatibility() Option {
+	return func(c *Collator) {
+		c.foldCompat = true
+	}
+}
+
+// foldCompat pushes the attributes of the decomposition of the compatibility character r into the buffer.
+// The code point of r is pushed into the tiebreak weights.
+func (it *iter) foldCompat(r rune) {
+	it.tiebreak = append(it.tiebreak, int(r))
+
+	// the decomposition is iterated separately, so that the rest of the string is not copied.
+	sub := iter{
+		c:     it.c,
+		s:     compatDecomposition[r],
+		last:  it.last,
+		last2: it.last2,
+	}
+	for {
+		a, ok := sub.nextAttr()
+		if !ok {
+			break
+		}
+		it.buf = append(it.buf, a)
+	}
+	it.last, it.last2 = sub.last, sub.last2
+	it.tiebreak = append(it.tiebreak, sub.tiebreak...)
+}
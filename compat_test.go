@@ -0,0 +1,117 @@
+package jisx4061
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFoldCompatibility(t *testing.T) {
+	c := New(FoldCompatibility())
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		// the folded characters are compared as their decompositions.
+		{"㈱", "（株）", 1},
+		{"㈱", "株", -1},
+		{"‼", "！！", 1},
+		{"‼", "！", 1},
+		{"㌔", "キロ", 1},
+		{"㌔", "キログラム", -1},
+		{"㌔", "キリ", 1},
+		{"㍻", "平成", 1},
+		{"㍻", "平", 1},
+		{"㍿", "株式会社", 1},
+		{"ﬁle", "file", 1},
+		{"ﬁle", "filf", -1},
+		{"ﬁle", "fila", 1},
+		{"x²", "x2", 1},
+		{"x²", "x3", -1},
+		{"", "", 0},
+
+		// 長音記号 after folded characters
+		{"㌔ー", "キロー", 1},
+		{"㌔ー", "キロウ", 1},
+		{"㌔ー", "キロカ", -1},
+	}
+	for _, tt := range tests {
+		if got := c.Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := c.Compare(tt.b, tt.a); got != -tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+		if got := bytes.Compare(c.Key(tt.a), c.Key(tt.b)); got != tt.want {
+			t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestFoldCompatibility_Strength(t *testing.T) {
+	// the original form is used only as the final tiebreak.
+	c := New(FoldCompatibility(), WithStrength(Quinary))
+	tests := [][2]string{
+		{"㈱", "（株）"},
+		{"㌔", "キロ"},
+		{"ﬁ", "fi"},
+		{"‼", "！！"},
+	}
+	for _, tt := range tests {
+		if got := c.Compare(tt[0], tt[1]); got != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", tt[0], tt[1], got)
+		}
+	}
+
+	// the symbols in the decompositions are not ignored.
+	c = New(FoldCompatibility(), WithStrength(Primary))
+	tests = [][2]string{
+		{"㈱", "株"},
+		{"‼", ""},
+	}
+	for _, tt := range tests {
+		if got := c.Compare(tt[0], tt[1]); got == 0 {
+			t.Errorf("Compare(%q, %q) = 0, want non-zero", tt[0], tt[1])
+		}
+	}
+}
+
+func TestFoldCompatibility_Disabled(t *testing.T) {
+	// compatibility characters are unknown runes by default.
+	if got := Compare("㌔", ""); got != 0 {
+		t.Errorf("Compare(%q, %q) = %d, want 0", "㌔", "", got)
+	}
+}
+
+func TestFoldCompatibility_Numeric(t *testing.T) {
+	c := New(FoldCompatibility(), Numeric())
+	if got := c.Compare("㉑", "3"); got != 1 {
+		t.Errorf("Compare(%q, %q) = %d, want 1", "㉑", "3", got)
+	}
+}
+
+func TestFoldCompatibility_Validate(t *testing.T) {
+	c := New(FoldCompatibility())
+	for _, s := range []string{"㌔", "㈱", "‼"} {
+		if err := c.Validate(s); err != nil {
+			t.Errorf("Validate(%q) = %v, want nil", s, err)
+		}
+	}
+
+	// the full-width quotation mark is not folded, because the quotation mark is not in the table.
+	var e *UnknownRuneError
+	if err := c.Validate("あ＂"); !errors.As(err, &e) {
+		t.Errorf("Validate(%q) = %v, want *UnknownRuneError", "あ＂", err)
+	} else if e.Rune != '＂' || e.Offset != 3 {
+		t.Errorf("Validate(%q) = %v, want U+FF02 at offset 3", "あ＂", err)
+	}
+}
+
+func BenchmarkKey_FoldCompatibility(b *testing.B) {
+	c := New(FoldCompatibility())
+	s := strings.Repeat("㈱", 10000)
+	for i := 0; i < b.N; i++ {
+		c.Key(s)
+	}
+}
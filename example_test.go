@@ -2,6 +2,7 @@ package jisx4061_test
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/shogo82148/jisx4061"
 )
@@ -29,3 +30,42 @@ func ExampleSort() {
 	// サトー
 	// さとおや
 }
+
+func ExampleNew() {
+	c := jisx4061.New(jisx4061.IgnoreKanaType())
+	fmt.Println(c.Compare("さとう", "サトウ"))
+	fmt.Println(jisx4061.Compare("さとう", "サトウ"))
+	// Output:
+	// 0
+	// -1
+}
+
+func ExampleParseTailoring() {
+	tailoring, err := jisx4061.ParseTailoring(strings.NewReader(
+		"文字\t文字クラス\t番号\tダイアクリティカルマーク\t大小\t清濁\t記号種別\t仮名種別\n" +
+			"㊙\t一般記号\t1000\t\t\t\t\t\n",
+	))
+	if err != nil {
+		panic(err)
+	}
+	c := jisx4061.New(jisx4061.WithTailoring(tailoring))
+	fmt.Println(c.Compare("㊙", ""))
+	fmt.Println(jisx4061.Compare("㊙", ""))
+	// Output:
+	// 1
+	// 0
+}
+
+func ExampleExplain() {
+	fmt.Print(jisx4061.Explain("さとう", "さどう"))
+	// Output:
+	// "さとう" < "さどう": decided at Secondary level by element 1
+	// A:
+	//   0: "さ" 仮名 11 (さ) 清音 大文字 平仮名
+	// * 1: "と" 仮名 20 (と) 清音 大文字 平仮名
+	//   2: "う" 仮名 3 (う) 清音 大文字 平仮名
+	// B:
+	//   0: "さ" 仮名 11 (さ) 清音 大文字 平仮名
+	// * 1: "ど" 仮名 20 (と) 濁音 大文字 平仮名
+	//   2: "う" 仮名 3 (う) 清音 大文字 平仮名
+}
@@ -0,0 +1,237 @@
+package jisx4061
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shogo82148/jisx4061/internal/table"
+)
+
+// Explanation describes how the strings A and B are compared.
+// It is intended for debugging the collation order, and the format of [Explanation.String] may change.
+type Explanation struct {
+	A, B string
+
+	// ElementsA and ElementsB are the collation elements of A and B.
+	ElementsA, ElementsB []Element
+
+	// Result is the result of the comparison, which is same as [Collator.Compare].
+	Result int
+
+	// Level is the level at which the result was decided.
+	// It is zero if A and B are equal or the result was decided by the tiebreak weights.
+	Level Strength
+
+	// Tiebreak reports whether the result was decided by the tiebreak weights,
+	// such as the leading zeros of numbers and the kanji of readings.
+	Tiebreak bool
+
+	// Position is the index of the element that decided the result.
+	// If one of the strings is a prefix of the other, it is the length of the shorter one.
+	// If Tiebreak is true, it is the index of the tiebreak weight.
+	// It is -1 if A and B are equal.
+	Position int
+}
+
+// Element is a collation element with its attributes.
+// The attributes are the names used in the collation table, and empty if not applicable.
+type Element struct {
+	// Offset is the byte offset of Text in the string.
+	Offset int
+
+	// Text is the part of the string from which the element comes.
+	// It includes the ignored runes before the element.
+	// Some text, such as numbers, readings of kanji and くの字点, produces several elements.
+	Text string
+
+	Class string // 文字クラス
+	Order int    // 番号
+
+	// Kana is the base kana of the order if the class is 仮名.
+	// It shows how 長音記号 and 繰返し記号 are resolved, e.g. ー in "かー" is あ.
+	Kana rune
+
+	Voiced          string // 清濁
+	SymbolType      string // 記号種別
+	KanaType        string // 仮名種別
+	DiacriticalMark string // ダイアクリティカルマーク
+	LetterCase      string // 大小
+}
+
+// The names of the attributes, which extend the names in the collation table.
+var (
+	classNames      = append(table.ClassNames[:len(table.ClassNames):len(table.ClassNames)], "未知の文字")
+	symbolTypeNames = append(table.SymbolTypeNames[:len(table.SymbolTypeNames):len(table.SymbolTypeNames)],
+		"丸数字", "括弧付き数字", "ピリオド付き数字", "ローマ数字")
+)
+
+// attrName returns the name of the attribute value v.
+// It returns an empty string for zero, which means the attribute is not applicable.
+func attrName(names []string, v int) string {
+	if v <= 0 || v >= len(names) {
+		return ""
+	}
+	return names[v]
+}
+
+var strengthNames = [...]string{
+	Primary:    "Primary",
+	Secondary:  "Secondary",
+	Tertiary:   "Tertiary",
+	Quaternary: "Quaternary",
+	Quinary:    "Quinary",
+	Senary:     "Senary",
+}
+
+// String returns the name of the level.
+func (s Strength) String() string {
+	if s < Primary || s > Senary {
+		return fmt.Sprintf("Strength(%d)", int(s))
+	}
+	return strengthNames[s]
+}
+
+// Explain explains how the strings a and b are compared according to JIS X 4061.
+func Explain(a, b string) Explanation {
+	return defaultCollator.Explain(a, b)
+}
+
+// Explain explains how the strings a and b are compared with the collator's options.
+// The result is same as [Collator.Compare].
+func (c *Collator) Explain(a, b string) Explanation {
+	r := c.compareIter(c.newIter(a), c.newIter(b))
+	return Explanation{
+		A:         a,
+		B:         b,
+		ElementsA: c.explainElements(a),
+		ElementsB: c.explainElements(b),
+		Result:    r.result,
+		Level:     r.level,
+		Tiebreak:  r.tiebreak,
+		Position:  r.pos,
+	}
+}
+
+// explainElements returns the collation elements of s.
+func (c *Collator) explainElements(s string) []Element {
+	var elems []Element
+	var text string
+	offset := 0
+	it := c.newIter(s)
+	for {
+		a, ok := it.next()
+		if !ok {
+			break
+		}
+
+		if it.i > offset {
+			text = s[offset:it.i]
+			offset = it.i
+		}
+
+		elem := Element{
+			Offset:          offset - len(text),
+			Text:            text,
+			Class:           attrName(classNames, int(a.class)),
+			Order:           a.order,
+			Voiced:          attrName(table.VoicedNames, int(a.voiced)),
+			SymbolType:      attrName(symbolTypeNames, int(a.symbolType)),
+			KanaType:        attrName(table.KanaTypeNames, int(a.kanaType)),
+			DiacriticalMark: attrName(table.DiacriticalMarkNames, int(a.diacriticalMark)),
+			LetterCase:      attrName(table.LetterCaseNames, int(a.letterCase)),
+		}
+		if a.class == classKana {
+			elem.Kana = c.kanaOf(a.order)
+		}
+		elems = append(elems, elem)
+	}
+	return elems
+}
+
+// kanaBases maps the orders of kana in the Gojūon order to the hiragana without voicing.
+var kanaBases = func() []rune {
+	var bases []rune
+	for r := 'ぁ'; r <= 'ゖ'; r++ {
+		a, ok := lookup(r)
+		if !ok || a.class != classKana || a.voiced > voicedUnvoiced || a.symbolType == symbolTypeLower {
+			continue
+		}
+		for len(bases) <= a.order {
+			bases = append(bases, 0)
+		}
+		if bases[a.order] == 0 {
+			bases[a.order] = r
+		}
+	}
+	return bases
+}()
+
+// kanaOf returns the hiragana without voicing whose order is order.
+// It returns 0 if there is no such kana, e.g. the order of a repeat mark.
+func (c *Collator) kanaOf(order int) rune {
+	if c.kanaOrder == KanaOrderIroha {
+		if kana := []rune(iroha); 0 < order && order <= len(kana) {
+			return kana[order-1]
+		}
+		return 0
+	}
+	if 0 < order && order < len(kanaBases) {
+		return kanaBases[order]
+	}
+	return 0
+}
+
+// String returns the explanation in a human readable form.
+// The element that decided the result is marked with "*".
+func (e Explanation) String() string {
+	var buf strings.Builder
+	op := "=="
+	switch e.Result {
+	case -1:
+		op = "<"
+	case 1:
+		op = ">"
+	}
+	fmt.Fprintf(&buf, "%q %s %q", e.A, op, e.B)
+	switch {
+	case e.Tiebreak:
+		fmt.Fprintf(&buf, ": decided by the tiebreak weight %d\n", e.Position)
+	case e.Level != 0:
+		fmt.Fprintf(&buf, ": decided at %s level by element %d\n", e.Level, e.Position)
+	default:
+		buf.WriteString("\n")
+	}
+
+	writeElements := func(name string, elems []Element) {
+		fmt.Fprintf(&buf, "%s:\n", name)
+		for i, elem := range elems {
+			mark := " "
+			if !e.Tiebreak && e.Level != 0 && i == e.Position {
+				mark = "*"
+			}
+			fmt.Fprintf(&buf, "%s %d: %q %s\n", mark, i, elem.Text, elem)
+		}
+		if !e.Tiebreak && e.Level != 0 && e.Position == len(elems) {
+			fmt.Fprintf(&buf, "* %d: (end)\n", len(elems))
+		}
+	}
+	writeElements("A", e.ElementsA)
+	writeElements("B", e.ElementsB)
+	return buf.String()
+}
+
+// String returns the attributes of the element.
+func (e Element) String() string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "%s %d", e.Class, e.Order)
+	if e.Kana != 0 {
+		fmt.Fprintf(&buf, " (%c)", e.Kana)
+	}
+	for _, name := range []string{e.Voiced, e.SymbolType, e.KanaType, e.DiacriticalMark, e.LetterCase} {
+		if name != "" {
+			buf.WriteString(" ")
+			buf.WriteString(name)
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,115 @@
+package jisx4061
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		result   int
+		level    Strength
+		position int
+	}{
+		{"さとう", "さとう", 0, 0, -1},
+		{"さと", "さとう", -1, Primary, 2},
+		{"さとう", "さと", 1, Primary, 2},
+		{"さとう", "さとお", -1, Primary, 2},
+		{"さとう", "さどう", -1, Secondary, 1},
+		{"かー", "かあ", -1, Tertiary, 1},
+		{"さとう", "サトウ", -1, Quaternary, 0},
+		{"ａ", "Ａ", -1, Senary, 0},
+
+		// the first difference at a lower level is reported.
+		{"サとう", "さどう", -1, Secondary, 1},
+	}
+	for _, tt := range tests {
+		e := Explain(tt.a, tt.b)
+		if e.Result != tt.result || e.Level != tt.level || e.Position != tt.position || e.Tiebreak {
+			t.Errorf("Explain(%q, %q) = (%d, %v, %d, %v), want (%d, %v, %d, false)",
+				tt.a, tt.b, e.Result, e.Level, e.Position, e.Tiebreak, tt.result, tt.level, tt.position)
+		}
+	}
+}
+
+func TestExplain_Tiebreak(t *testing.T) {
+	c := New(Numeric())
+	e := c.Explain("1", "01")
+	if e.Result != -1 || !e.Tiebreak || e.Level != 0 || e.Position != 0 {
+		t.Errorf("Explain(%q, %q) = (%d, %v, %d, %v), want (-1, 0, 0, true)", "1", "01", e.Result, e.Level, e.Position, e.Tiebreak)
+	}
+}
+
+func TestExplain_Elements(t *testing.T) {
+	c := New(FoldCompatibility())
+	e := c.Explain("かゝー", "a㈱")
+	wantA := []Element{
+		{Offset: 0, Text: "か", Class: "仮名", Order: 6, Kana: 'か', Voiced: "清音", SymbolType: "大文字", KanaType: "平仮名"},
+		{Offset: 3, Text: "ゝ", Class: "仮名", Order: 6, Kana: 'か', Voiced: "清音", SymbolType: "繰返し記号", KanaType: "平仮名"},
+		{Offset: 6, Text: "ー", Class: "仮名", Order: 1, Kana: 'あ', Voiced: "清音", SymbolType: "長音記号", KanaType: "片仮名"},
+	}
+	checkElements(t, e.ElementsA, wantA)
+
+	wantB := []Element{
+		{Offset: 0, Text: "a", Class: "ラテンアルファベット", Order: 1, LetterCase: "小文字"},
+		{Offset: 1, Text: "㈱", Class: "括弧記号", Order: 5},
+		{Offset: 1, Text: "㈱", Class: "漢字", Order: int('株')},
+		{Offset: 1, Text: "㈱", Class: "括弧記号", Order: 6},
+	}
+	checkElements(t, e.ElementsB, wantB)
+}
+
+func checkElements(t *testing.T, got, want []Element) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("got %d elements, want %d", len(got), len(want))
+		return
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("element %d: got %#v, want %#v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestExplain_Compare(t *testing.T) {
+	var list []string
+	for _, tt := range lessTests {
+		list = append(list, tt...)
+	}
+	// the cases commented out in lessTests
+	list = append(list, "てぇたｇ", "てぇたＧ", "びゆーあー")
+	for _, c := range differentialCollators {
+		for _, a := range list {
+			for _, b := range list {
+				want := c.Compare(a, b)
+				if got := c.Explain(a, b).Result; got != want {
+					t.Errorf("Explain(%q, %q).Result = %d, want %d", a, b, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestExplanation_String(t *testing.T) {
+	got := Explain("さと", "さとう").String()
+	want := `"さと" < "さとう": decided at Primary level by element 2
+A:
+  0: "さ" 仮名 11 (さ) 清音 大文字 平仮名
+  1: "と" 仮名 20 (と) 清音 大文字 平仮名
+* 2: (end)
+B:
+  0: "さ" 仮名 11 (さ) 清音 大文字 平仮名
+  1: "と" 仮名 20 (と) 清音 大文字 平仮名
+* 2: "う" 仮名 3 (う) 清音 大文字 平仮名
+`
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	got = Explain("ab", "ab").String()
+	if !strings.HasPrefix(got, `"ab" == "ab"`+"\n") {
+		t.Errorf("unexpected explanation:\n%s", got)
+	}
+}
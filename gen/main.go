@@ -9,19 +9,254 @@ import (
 	"io"
 	"log"
 	"os"
+	"sort"
 	"strconv"
+	"strings"
 	"unicode/utf8"
+
+	"github.com/shogo82148/jisx4061/internal/table"
 )
 
 func main() {
+	entries := genTable()
+	genCJKCompat()
+	genRadical()
+	genJIS()
+	genCompat(entries)
+}
+
+// The names of the constants in the jisx4061 package.
+// The index of each name is the value of the constant.
+var (
+	classIdents = []string{
+		"",
+		"classSpace",
+		"classDescriptor",
+		"classBracket",
+		"classScience",
+		"classGeneral",
+		"classUnit",
+		"classNumber",
+		"classSymbol",
+		"classAlphabet",
+		"classKana",
+		"classKanji",
+		"classGeta",
+	}
+	diacriticalMarkIdents = []string{
+		"diacriticalMarkNone",
+		"diacriticalMarkMacron",
+		"diacriticalMarkCircumflexAccent",
+	}
+	letterCaseIdents = []string{
+		"letterCaseNone",
+		"letterCaseLower",
+		"letterCaseUpper",
+	}
+	voicedIdents = []string{
+		"voicedNone",
+		"voicedUnvoiced",
+		"voicedVoiced",
+		"voicedSemivoiced",
+	}
+	symbolTypeIdents = []string{
+		"symbolTypeNone",
+		"symbolTypeLongVowel",
+		"symbolTypeLower",
+		"symbolTypeRepeat",
+		"symbolTypeUpper",
+	}
+	kanaTypeIdents = []string{
+		"kanaTypeNone",
+		"kanaTypeHiragana",
+		"kanaTypeKatakana",
+	}
+)
+
+// literal returns the attribute of e in Go syntax.
+func literal(e *table.Entry) string {
+	buf := new(bytes.Buffer)
+	fmt.Fprint(buf, "{\n")
+	fmt.Fprintf(buf, "class: %s,\n", classIdents[e.Class])
+	fmt.Fprintf(buf, "order: %d,\n", e.Order)
+	if e.DiacriticalMark != 0 {
+		fmt.Fprintf(buf, "diacriticalMark: %s,\n", diacriticalMarkIdents[e.DiacriticalMark])
+	}
+	if e.LetterCase != 0 {
+		fmt.Fprintf(buf, "letterCase: %s,\n", letterCaseIdents[e.LetterCase])
+	}
+	if e.Voiced != 0 {
+		fmt.Fprintf(buf, "voiced: %s,\n", voicedIdents[e.Voiced])
+	}
+	if e.SymbolType != 0 {
+		fmt.Fprintf(buf, "symbolType: %s,\n", symbolTypeIdents[e.SymbolType])
+	}
+	if e.KanaType != 0 {
+		fmt.Fprintf(buf, "kanaType: %s,\n", kanaTypeIdents[e.KanaType])
+	}
+	fmt.Fprint(buf, "}")
+	return buf.String()
+}
+
+// fatalTable reports the errors in table.tsv and exits.
+func fatalTable(err error) {
+	var list table.ErrorList
+	if !errors.As(err, &list) {
+		log.Fatal(err)
+	}
+	for _, e := range list {
+		fmt.Fprintf(os.Stderr, "table.tsv:%d: %s\n", e.Line, e.Msg)
+	}
+	os.Exit(1)
+}
+
+func genTable() []*table.Entry {
 	f, err := os.Open("table.tsv")
 	if err != nil {
 		log.Fatal(err)
 	}
 	defer f.Close()
 
+	entries, err := table.Parse(f)
+	if err != nil {
+		fatalTable(err)
+	}
+	var errs table.ErrorList
+	for _, validate := range []func([]*table.Entry) error{
+		table.ValidateDuplicates,
+		table.ValidateKanaPairs,
+		table.ValidateOrders,
+	} {
+		var list table.ErrorList
+		if errors.As(validate(entries), &list) {
+			errs = append(errs, list...)
+		}
+	}
+	if err := errs.Err(); err != nil {
+		fatalTable(err)
+	}
+
+	// the attributes of each rune, written in Go syntax.
+	attrs := map[rune]string{}
+	var maxRune rune
+	for _, e := range entries {
+		attrs[e.Rune] = literal(e)
+		if e.Rune > maxRune {
+			maxRune = e.Rune
+		}
+	}
+
+	data, err := format.Source(generate(attrs, maxRune))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("table_gen.go", data, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	return entries
+}
+
+// generate generates the two-stage lookup table.
+// The first stage tableIndex maps the upper bits of a rune to a block,
+// and the second stage tableBlocks maps the lower bits to an index of tableEntries.
+// The same blocks and the same entries are shared.
+func generate(attrs map[rune]string, maxRune rune) []byte {
+	const blockBits = 6
+	const blockSize = 1 << blockBits
+
+	// the index 0 is reserved for the runes that are not in the table.
+	entries := []string{"{}"}
+	entryIndex := map[string]int{"{}": 0}
+	entryRunes := [][]rune{nil}
+
+	// the block 0 is reserved for the blocks that have no entries.
+	blocks := [][]int{make([]int, blockSize)}
+	blockIndex := map[string]int{fmt.Sprint(blocks[0]): 0}
+
+	var index []int
+	for base := rune(0); base <= maxRune; base += blockSize {
+		block := make([]int, blockSize)
+		for i := range block {
+			lit, ok := attrs[base+rune(i)]
+			if !ok {
+				continue
+			}
+			idx, ok := entryIndex[lit]
+			if !ok {
+				idx = len(entries)
+				entries = append(entries, lit)
+				entryIndex[lit] = idx
+				entryRunes = append(entryRunes, nil)
+			}
+			entryRunes[idx] = append(entryRunes[idx], base+rune(i))
+			block[i] = idx
+		}
+
+		key := fmt.Sprint(block)
+		idx, ok := blockIndex[key]
+		if !ok {
+			idx = len(blocks)
+			blocks = append(blocks, block)
+			blockIndex[key] = idx
+		}
+		index = append(index, idx)
+	}
+	if len(blocks) > 256 {
+		log.Fatal("too many blocks")
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "// Code generated by gen/main.go; DO NOT EDIT.")
+	fmt.Fprintln(buf, "")
+	fmt.Fprintln(buf, "package jisx4061")
+	fmt.Fprintln(buf, "")
+	fmt.Fprintf(buf, "const tableBlockBits = %d\n", blockBits)
+	fmt.Fprintf(buf, "const tableMax = 0x%x\n", len(index)*blockSize)
+	fmt.Fprintln(buf, "")
+
+	fmt.Fprintf(buf, "var tableIndex = [%d]uint8{", len(index))
+	for i, idx := range index {
+		if i%16 == 0 {
+			fmt.Fprint(buf, "\n")
+		}
+		fmt.Fprintf(buf, "%d, ", idx)
+	}
+	fmt.Fprint(buf, "\n}\n\n")
+
+	fmt.Fprintf(buf, "var tableBlocks = [%d]uint16{", len(blocks)*blockSize)
+	for i, block := range blocks {
+		fmt.Fprintf(buf, "\n// block %d\n", i)
+		for j, idx := range block {
+			if j > 0 && j%16 == 0 {
+				fmt.Fprint(buf, "\n")
+			}
+			fmt.Fprintf(buf, "%d, ", idx)
+		}
+	}
+	fmt.Fprint(buf, "\n}\n\n")
+
+	fmt.Fprintf(buf, "var tableEntries = [%d]attr{\n", len(entries))
+	for i, lit := range entries {
+		if len(entryRunes[i]) > 0 {
+			fmt.Fprintf(buf, "// %q\n", string(entryRunes[i]))
+		}
+		fmt.Fprintf(buf, "%s,\n", lit)
+	}
+	fmt.Fprint(buf, "}\n")
+	return buf.Bytes()
+}
+
+// genCJKCompat generates the mapping from CJK compatibility ideographs to their canonical decompositions.
+func genCJKCompat() {
+	f, err := os.Open("cjkcompat.tsv")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
 	p := csv.NewReader(f)
 	p.Comma = '\t'
+	p.Comment = '#'
 
 	// skip header
 	_, err = p.Read()
@@ -34,8 +269,8 @@ func main() {
 	fmt.Fprintln(buf, "")
 	fmt.Fprintln(buf, "package jisx4061")
 	fmt.Fprintln(buf, "")
-	fmt.Fprintln(buf, "var table = map[rune]attr{")
-
+	fmt.Fprintln(buf, "// cjkCompat maps CJK compatibility ideographs to their canonical decompositions.")
+	fmt.Fprintln(buf, "var cjkCompat = map[rune]rune{")
 	for {
 		record, err := p.Read()
 		if errors.Is(err, io.EOF) {
@@ -44,145 +279,318 @@ func main() {
 		if err != nil {
 			log.Fatal(err)
 		}
+		line, _ := p.FieldPos(0)
+
+		from, n := utf8.DecodeRuneInString(record[0])
+		if n != len(record[0]) {
+			log.Fatalf("cjkcompat.tsv:%d: too many characters", line)
+		}
+		to, n := utf8.DecodeRuneInString(record[1])
+		if n != len(record[1]) {
+			log.Fatalf("cjkcompat.tsv:%d: too many characters", line)
+		}
+		fmt.Fprintf(buf, "0x%X: 0x%X, // %c -> %c\n", from, to, from, to)
+	}
+	fmt.Fprintln(buf, "}")
+
+	data, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("cjkcompat_gen.go", data, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
 
-		if len(record[0]) == 0 {
+// toFullWidth replaces the ASCII symbols in s that are not in the table with their full-width forms.
+// It reports false if some of them have no full-width forms in the table.
+func toFullWidth(s []rune, inTable map[rune]bool) bool {
+	for i, r := range s {
+		if r >= utf8.RuneSelf || inTable[r] {
 			continue
 		}
+		if wide := r - '!' + '！'; '!' <= r && r <= '~' && inTable[wide] {
+			s[i] = wide
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// genRadical generates the ranks of kanji in the radical-stroke order (部首画数順).
+func genRadical() {
+	f, err := os.Open("radical.tsv")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	p := csv.NewReader(f)
+	p.Comma = '\t'
+	p.Comment = '#'
+
+	// skip header
+	_, err = p.Read()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// the rows are in the order of the radicals, and the simplified forms follow their traditional forms.
+	// The kanji in each row are already in the order of the residual stroke counts;
+	// radical.tsv has no stroke counts, so they are used as is.
+	ranks := map[rune]int{}
+	lastRadical := 0
+	for {
+		record, err := p.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		line, _ := p.FieldPos(0)
+
+		radical, err := strconv.Atoi(strings.TrimSuffix(record[0], "'"))
+		if err != nil || radical < 1 || radical > 214 {
+			log.Fatalf("radical.tsv:%d: invalid radical %q", line, record[0])
+		}
+		radical *= 2
+		if strings.HasSuffix(record[0], "'") {
+			radical++
+		}
+		if radical <= lastRadical {
+			log.Fatalf("radical.tsv:%d: radical %q is out of order", line, record[0])
+		}
+		lastRadical = radical
+
+		for _, r := range record[1] {
+			if _, ok := ranks[r]; ok {
+				log.Fatalf("radical.tsv:%d: duplicated kanji %q", line, r)
+			}
+			ranks[r] = len(ranks) + 1
+		}
+	}
+
+	runes := make([]rune, 0, len(ranks))
+	for r := range ranks {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "// Code generated by gen/main.go; DO NOT EDIT.")
+	fmt.Fprintln(buf, "")
+	fmt.Fprintln(buf, "package jisx4061")
+	fmt.Fprintln(buf, "")
+	fmt.Fprintf(buf, "const radicalStrokeCount = %d\n", len(ranks))
+	fmt.Fprintln(buf, "")
+	fmt.Fprintln(buf, "// radicalStrokeRanges are the ranges of the kanji in the radical-stroke data.")
+	fmt.Fprintln(buf, "// The ranks of the kanji from lo to hi start at radicalStrokeRank[offset].")
+	fmt.Fprintln(buf, "var radicalStrokeRanges = [...]struct {")
+	fmt.Fprintln(buf, "lo, hi rune")
+	fmt.Fprintln(buf, "offset int")
+	fmt.Fprintln(buf, "}{")
+	for i := 0; i < len(runes); {
+		j := i + 1
+		for j < len(runes) && runes[j] == runes[j-1]+1 {
+			j++
+		}
+		fmt.Fprintf(buf, "{0x%X, 0x%X, %d},\n", runes[i], runes[j-1], i)
+		i = j
+	}
+	fmt.Fprintln(buf, "}")
+	fmt.Fprintln(buf, "")
+	fmt.Fprintln(buf, "// radicalStrokeRank is the rank of each kanji in the radical-stroke order.")
+	fmt.Fprintf(buf, "var radicalStrokeRank = [%d]uint32{", len(runes))
+	for i, r := range runes {
+		if i%16 == 0 {
+			fmt.Fprint(buf, "\n")
+		}
+		fmt.Fprintf(buf, "%d, ", ranks[r])
+	}
+	fmt.Fprint(buf, "\n}\n")
+
+	data, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("radical_gen.go", data, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// genJIS generates the positions (面区点) of kanji in JIS X 0213.
+func genJIS() {
+	f, err := os.Open("jisx0213.tsv")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	p := csv.NewReader(f)
+	p.Comma = '\t'
+	p.Comment = '#'
+
+	// skip header
+	_, err = p.Read()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	type position struct {
+		r   rune
+		pos int
+	}
+	var list []position
+	seen := make(map[rune]int)
+	for {
+		record, err := p.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
 		line, _ := p.FieldPos(0)
 
 		r, n := utf8.DecodeRuneInString(record[0])
 		if n != len(record[0]) {
-			log.Fatalf("too many characters on line %d", line)
-		}
-		fmt.Fprintf(buf, "'%c': {\n", r)
-
-		// 文字クラス
-		fmt.Fprint(buf, "class: ")
-		switch record[1] {
-		case "スペース":
-			fmt.Fprint(buf, "classSpace")
-		case "記述記号":
-			fmt.Fprint(buf, "classDescriptor")
-		case "括弧記号":
-			fmt.Fprint(buf, "classBracket")
-		case "学術記号":
-			fmt.Fprint(buf, "classScience")
-		case "一般記号":
-			fmt.Fprint(buf, "classGeneral")
-		case "単位記号":
-			fmt.Fprint(buf, "classUnit")
-		case "アラビア数字":
-			fmt.Fprint(buf, "classNumber")
-		case "欧字記号":
-			fmt.Fprint(buf, "classSymbol")
-		case "ラテンアルファベット":
-			fmt.Fprint(buf, "classAlphabet")
-		case "仮名":
-			fmt.Fprint(buf, "classKana")
-		case "漢字":
-			fmt.Fprint(buf, "classKanji")
-		case "げた記号":
-			fmt.Fprint(buf, "classGeta")
-		default:
-			log.Fatalf("unknown class %q on line %d", record[1], line)
-		}
-		fmt.Fprintln(buf, ",")
-
-		// 番号
-		order, err := strconv.Atoi(record[2])
+			log.Fatalf("jisx0213.tsv:%d: too many characters", line)
+		}
+		if prev, ok := seen[r]; ok {
+			log.Fatalf("jisx0213.tsv:%d: duplicated character %q, first defined at line %d", line, r, prev)
+		}
+		seen[r] = line
+		plane, err := strconv.Atoi(record[1])
+		if err != nil || plane < 1 || plane > 2 {
+			log.Fatalf("jisx0213.tsv:%d: invalid plane %q", line, record[1])
+		}
+		row, err := strconv.Atoi(record[2])
+		if err != nil || row < 1 || row > 94 {
+			log.Fatalf("jisx0213.tsv:%d: invalid row %q", line, record[2])
+		}
+		cell, err := strconv.Atoi(record[3])
+		if err != nil || cell < 1 || cell > 94 {
+			log.Fatalf("jisx0213.tsv:%d: invalid cell %q", line, record[3])
+		}
+		list = append(list, position{r: r, pos: ((plane-1)*94+row-1)*94 + cell})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].r < list[j].r
+	})
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "// Code generated by gen/main.go; DO NOT EDIT.")
+	fmt.Fprintln(buf, "")
+	fmt.Fprintln(buf, "package jisx4061")
+	fmt.Fprintln(buf, "")
+	fmt.Fprintln(buf, "// jisPositionCount is the number of positions in the two planes of JIS X 0213.")
+	fmt.Fprintf(buf, "const jisPositionCount = %d\n", 2*94*94)
+	fmt.Fprintln(buf, "")
+	fmt.Fprintln(buf, "// jisRunes is the sorted list of kanji in JIS X 0213.")
+	fmt.Fprintf(buf, "var jisRunes = [%d]rune{", len(list))
+	for i, jp := range list {
+		if i%8 == 0 {
+			fmt.Fprint(buf, "\n")
+		}
+		fmt.Fprintf(buf, "0x%04X, ", jp.r)
+	}
+	fmt.Fprint(buf, "\n}\n\n")
+	fmt.Fprintln(buf, "// jisPositions is the position of each kanji in jisRunes.")
+	fmt.Fprintln(buf, "// The position of 面-区-点 is ((面-1)*94+区-1)*94+点.")
+	fmt.Fprintf(buf, "var jisPositions = [%d]uint16{", len(list))
+	for i, jp := range list {
+		if i%16 == 0 {
+			fmt.Fprint(buf, "\n")
+		}
+		fmt.Fprintf(buf, "%d, ", jp.pos)
+	}
+	fmt.Fprint(buf, "\n}\n")
+
+	data, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("jis_gen.go", data, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// genCompat generates the mapping from compatibility characters to their NFKC forms.
+// ASCII symbols in the NFKC forms are replaced with their full-width forms in the table, e.g. ( with （,
+// and the characters whose NFKC forms have symbols that are not in the table are skipped.
+func genCompat(entries []*table.Entry) {
+	inTable := map[rune]bool{}
+	for _, e := range entries {
+		inTable[e.Rune] = true
+	}
+
+	f, err := os.Open("compat.tsv")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	p := csv.NewReader(f)
+	p.Comma = '\t'
+	p.Comment = '#'
+
+	// skip header
+	_, err = p.Read()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "// Code generated by gen/main.go; DO NOT EDIT.")
+	fmt.Fprintln(buf, "")
+	fmt.Fprintln(buf, "package jisx4061")
+	fmt.Fprintln(buf, "")
+	fmt.Fprintln(buf, "// compatDecomposition maps compatibility characters to their NFKC forms.")
+	fmt.Fprintln(buf, "var compatDecomposition = map[rune]string{")
+	for {
+		record, err := p.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
 		if err != nil {
-			line, _ := p.FieldPos(0)
-			log.Fatalf("failed to parse order on line %d: %v", line, err)
-		}
-		fmt.Fprintf(buf, "order: %d,\n", order)
-
-		// ダイアクリティカルマーク
-		if v := record[3]; v != "" {
-			fmt.Fprint(buf, "diacriticalMark: ")
-			switch v {
-			case "ダイアクリティカルマークなし":
-				fmt.Fprint(buf, "diacriticalMarkNone")
-			case "マクロン付き":
-				fmt.Fprint(buf, "diacriticalMarkMacron")
-			case "サーカムフレックスアクセント付き":
-				fmt.Fprint(buf, "diacriticalMarkCircumflexAccent")
-			default:
-				log.Fatalf("unknown diacriticalMark %q on line %d", v, line)
-			}
-			fmt.Fprintln(buf, ",")
-		}
-
-		// 大小
-		if v := record[4]; v != "" {
-			fmt.Fprint(buf, "letterCase: ")
-			switch v {
-			case "小文字":
-				fmt.Fprint(buf, "letterCaseLower")
-			case "大文字":
-				fmt.Fprint(buf, "letterCaseUpper")
-			default:
-				log.Fatalf("unknown letterCase %q on line %d", v, line)
-			}
-			fmt.Fprintln(buf, ",")
-		}
-
-		// 清濁
-		if v := record[5]; v != "" {
-			fmt.Fprint(buf, "voiced: ")
-			switch v {
-			case "清音":
-				fmt.Fprint(buf, "voicedUnvoiced")
-			case "濁音":
-				fmt.Fprint(buf, "voicedVoiced")
-			case "半濁音":
-				fmt.Fprint(buf, "voicedSemivoiced")
-			default:
-				log.Fatalf("unknown voiced %q on line %d", v, line)
-			}
-			fmt.Fprintln(buf, ",")
-		}
-
-		// 記号種別
-		if v := record[6]; v != "" {
-			fmt.Fprint(buf, "symbolType: ")
-			switch v {
-			case "長音記号":
-				fmt.Fprint(buf, "symbolTypeLongVowel")
-			case "小文字":
-				fmt.Fprint(buf, "symbolTypeLower")
-			case "繰返し記号":
-				fmt.Fprint(buf, "symbolTypeLower")
-			case "大文字":
-				fmt.Fprint(buf, "symbolTypeUpper")
-			default:
-				log.Fatalf("unknown symbolType %q on line %d", v, line)
-			}
-			fmt.Fprintln(buf, ",")
-		}
-
-		// 仮名種別
-		if v := record[7]; v != "" {
-			fmt.Fprint(buf, "kanaType: ")
-			switch v {
-			case "平仮名":
-				fmt.Fprint(buf, "kanaTypeHiragana")
-			case "片仮名":
-				fmt.Fprint(buf, "kanaTypeKatakana")
-			default:
-				log.Fatalf("unknown kanaType %q on line %d", v, line)
-			}
-			fmt.Fprintln(buf, ",")
+			log.Fatal(err)
 		}
+		line, _ := p.FieldPos(0)
 
-		fmt.Fprint(buf, "},\n")
+		from, err := strconv.ParseUint(record[0], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(from)) {
+			log.Fatalf("compat.tsv:%d: invalid code point %q", line, record[0])
+		}
+		var to []rune
+		for _, field := range strings.Fields(record[1]) {
+			r, err := strconv.ParseUint(field, 16, 32)
+			if err != nil || !utf8.ValidRune(rune(r)) {
+				log.Fatalf("compat.tsv:%d: invalid code point %q", line, field)
+			}
+			to = append(to, rune(r))
+		}
+		if len(to) == 0 {
+			log.Fatalf("compat.tsv:%d: empty decomposition", line)
+		}
+		if !toFullWidth(to, inTable) {
+			continue
+		}
+		if record[2] != "" {
+			fmt.Fprintf(buf, "0x%X: %q, // %s\n", from, string(to), record[2])
+		} else {
+			fmt.Fprintf(buf, "0x%X: %q,\n", from, string(to))
+		}
 	}
-	fmt.Fprint(buf, "}\n")
+	fmt.Fprintln(buf, "}")
 
 	data, err := format.Source(buf.Bytes())
 	if err != nil {
 		log.Fatal(err)
 	}
-	if err := os.WriteFile("table_gen.go", data, 0o644); err != nil {
+	if err := os.WriteFile("compat_gen.go", data, 0o644); err != nil {
 		log.Fatal(err)
 	}
 }
@@ -0,0 +1,75 @@
+package jisx4061
+
+// halfwidthKana maps the half-width katakana (半角カナ) from U+FF61 to U+FF9D to the corresponding full-width characters.
+// The half-width voiced sound marks U+FF9E and U+FF9F are combined into the preceding kana by combineVoicedMark.
+var halfwidthKana = [...]rune{
+	'。', // ｡
+	'「', // ｢
+	'」', // ｣
+	'、', // ､
+	'・', // ･
+	'ヲ', // ｦ
+	'ァ', // ｧ
+	'ィ', // ｨ
+	'ゥ', // ｩ
+	'ェ', // ｪ
+	'ォ', // ｫ
+	'ャ', // ｬ
+	'ュ', // ｭ
+	'ョ', // ｮ
+	'ッ', // ｯ
+	'ー', // ｰ
+	'ア', // ｱ
+	'イ', // ｲ
+	'ウ', // ｳ
+	'エ', // ｴ
+	'オ', // ｵ
+	'カ', // ｶ
+	'キ', // ｷ
+	'ク', // ｸ
+	'ケ', // ｹ
+	'コ', // ｺ
+	'サ', // ｻ
+	'シ', // ｼ
+	'ス', // ｽ
+	'セ', // ｾ
+	'ソ', // ｿ
+	'タ', // ﾀ
+	'チ', // ﾁ
+	'ツ', // ﾂ
+	'テ', // ﾃ
+	'ト', // ﾄ
+	'ナ', // ﾅ
+	'ニ', // ﾆ
+	'ヌ', // ﾇ
+	'ネ', // ﾈ
+	'ノ', // ﾉ
+	'ハ', // ﾊ
+	'ヒ', // ﾋ
+	'フ', // ﾌ
+	'ヘ', // ﾍ
+	'ホ', // ﾎ
+	'マ', // ﾏ
+	'ミ', // ﾐ
+	'ム', // ﾑ
+	'メ', // ﾒ
+	'モ', // ﾓ
+	'ヤ', // ﾔ
+	'ユ', // ﾕ
+	'ヨ', // ﾖ
+	'ラ', // ﾗ
+	'リ', // ﾘ
+	'ル', // ﾙ
+	'レ', // ﾚ
+	'ロ', // ﾛ
+	'ワ', // ﾜ
+	'ン', // ﾝ
+}
+
+// toWide converts the half-width katakana r to the corresponding full-width character.
+func toWide(r rune) rune {
+	if 0xff61 <= r && int(r) < 0xff61+len(halfwidthKana) {
+		return halfwidthKana[r-0xff61]
+	}
+	return r
+}
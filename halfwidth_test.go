@@ -0,0 +1,40 @@
+package jisx4061
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestHalfwidthKana(t *testing.T) {
+	tests := []struct {
+		half, full string
+	}{
+		{"ｶﾞｯｺｳ", "ガッコウ"},
+		{"ｻﾄｳ", "サトウ"},
+		{"ｻﾄｰ", "サトー"},
+		{"ﾊﾟｰﾃｨｰ", "パーティー"},
+		{"ｳﾞｧｲｵﾘﾝ", "ヴァイオリン"},
+		{"ｼﾞｮﾝ･ｽﾐｽ", "ジョン・スミス"},
+		{"｢ｱ｣｡", "「ア」。"},
+	}
+	for _, tt := range tests {
+		if got := Compare(tt.half, tt.full); got != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", tt.half, tt.full, got)
+		}
+		if !bytes.Equal(Key(tt.half), Key(tt.full)) {
+			t.Errorf("want Key(%q) == Key(%q), but not", tt.half, tt.full)
+		}
+	}
+}
+
+func TestHalfwidthKana_Order(t *testing.T) {
+	list := []string{"か", "ｶ", "が", "ｶﾞ", "ｷ", "ﾊ", "ﾊﾞ", "ﾊﾟ", "ﾝ"}
+	for i, a := range list {
+		for j, b := range list {
+			want := i < j
+			if got := Less(a, b); got != want {
+				t.Errorf("want %s < %s is %t, but not", a, b, want)
+			}
+		}
+	}
+}
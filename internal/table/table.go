@@ -0,0 +1,264 @@
+// Package table parses the collation tables in the format of table.tsv.
+// It is shared by the generator and the tailoring of the jisx4061 package.
+package table
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// The names of the attributes in the table.
+// The index of each name is the value of the corresponding constant in the jisx4061 package.
+var (
+	ClassNames = []string{
+		"",
+		"スペース",
+		"記述記号",
+		"括弧記号",
+		"学術記号",
+		"一般記号",
+		"単位記号",
+		"アラビア数字",
+		"欧字記号",
+		"ラテンアルファベット",
+		"仮名",
+		"漢字",
+		"げた記号",
+	}
+	DiacriticalMarkNames = []string{
+		"ダイアクリティカルマークなし",
+		"マクロン付き",
+		"サーカムフレックスアクセント付き",
+	}
+	LetterCaseNames = []string{
+		"",
+		"小文字",
+		"大文字",
+	}
+	VoicedNames = []string{
+		"",
+		"清音",
+		"濁音",
+		"半濁音",
+	}
+	SymbolTypeNames = []string{
+		"",
+		"長音記号",
+		"小文字",
+		"繰返し記号",
+		"大文字",
+	}
+	KanaTypeNames = []string{
+		"",
+		"平仮名",
+		"片仮名",
+	}
+)
+
+// The values of the kana types.
+const (
+	KanaTypeHiragana = 1
+	KanaTypeKatakana = 2
+)
+
+// MaxOrder is the largest order that the table can have.
+// The sort keys of the jisx4061 package encode the orders in 31 bits.
+const MaxOrder = 1<<31 - 1
+
+// Entry is a row of the table.
+type Entry struct {
+	Line int
+	Rune rune
+
+	Class           int // 文字クラス
+	Order           int // 番号
+	DiacriticalMark int // ダイアクリティカルマーク
+	LetterCase      int // 大小
+	Voiced          int // 清濁
+	SymbolType      int // 記号種別
+	KanaType        int // 仮名種別
+}
+
+// Error is an error at a line of the table.
+type Error struct {
+	Line int
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// ErrorList is a list of errors, sorted by the lines.
+type ErrorList []*Error
+
+func (list ErrorList) Error() string {
+	switch len(list) {
+	case 0:
+		return "no errors"
+	case 1:
+		return list[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", list[0], len(list)-1)
+}
+
+func (list *ErrorList) add(line int, format string, args ...any) {
+	*list = append(*list, &Error{
+		Line: line,
+		Msg:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Err returns an error equivalent to list, or nil if list is empty.
+func (list ErrorList) Err() error {
+	if len(list) == 0 {
+		return nil
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Line < list[j].Line
+	})
+	return list
+}
+
+// Parse parses the table.
+// The first line is the header, and the rows that have no character are skipped.
+// It returns an [ErrorList] if there are syntax errors.
+func Parse(r io.Reader) ([]*Entry, error) {
+	s := bufio.NewScanner(r)
+	var entries []*Entry
+	var errs ErrorList
+	line := 0
+	for s.Scan() {
+		line++
+		if line == 1 {
+			// skip header
+			continue
+		}
+		fields := strings.Split(strings.TrimSuffix(s.Text(), "\r"), "\t")
+		if len(fields[0]) == 0 {
+			continue
+		}
+		if len(fields) != 8 {
+			errs.add(line, "wrong number of fields: want 8, got %d", len(fields))
+			continue
+		}
+
+		r, n := utf8.DecodeRuneInString(fields[0])
+		if r == utf8.RuneError || n != len(fields[0]) {
+			errs.add(line, "invalid character %q", fields[0])
+			continue
+		}
+		e := &Entry{
+			Line: line,
+			Rune: r,
+		}
+
+		// attr looks up the value of the attribute.
+		attr := func(names []string, kind, v string) int {
+			if v == "" {
+				return 0
+			}
+			for i, name := range names {
+				if name == v {
+					return i
+				}
+			}
+			errs.add(line, "unknown %s %q", kind, v)
+			return 0
+		}
+		if fields[1] == "" {
+			errs.add(line, "missing class")
+		}
+		e.Class = attr(ClassNames, "class", fields[1])
+		order, err := strconv.Atoi(fields[2])
+		if err != nil || order < 1 {
+			errs.add(line, "invalid order %q", fields[2])
+		} else if order > MaxOrder {
+			errs.add(line, "order %d exceeds the maximum %d", order, MaxOrder)
+		}
+		e.Order = order
+		e.DiacriticalMark = attr(DiacriticalMarkNames, "diacriticalMark", fields[3])
+		e.LetterCase = attr(LetterCaseNames, "letterCase", fields[4])
+		e.Voiced = attr(VoicedNames, "voiced", fields[5])
+		e.SymbolType = attr(SymbolTypeNames, "symbolType", fields[6])
+		e.KanaType = attr(KanaTypeNames, "kanaType", fields[7])
+		entries = append(entries, e)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ValidateDuplicates reports the runes that are defined twice.
+func ValidateDuplicates(entries []*Entry) error {
+	var errs ErrorList
+	byRune := make(map[rune]*Entry, len(entries))
+	for _, e := range entries {
+		if prev, ok := byRune[e.Rune]; ok {
+			errs.add(e.Line, "duplicated character %q, first defined at line %d", e.Rune, prev.Line)
+			continue
+		}
+		byRune[e.Rune] = e
+	}
+	return errs.Err()
+}
+
+// ValidateKanaPairs checks that each hiragana and the corresponding katakana differ only in the kana type.
+func ValidateKanaPairs(entries []*Entry) error {
+	var errs ErrorList
+	byRune := make(map[rune]*Entry, len(entries))
+	for _, e := range entries {
+		byRune[e.Rune] = e
+	}
+	for _, e := range entries {
+		if e.KanaType != KanaTypeHiragana {
+			continue
+		}
+		k, ok := byRune[e.Rune+('ァ'-'ぁ')]
+		if !ok {
+			continue
+		}
+		if k.KanaType != KanaTypeKatakana {
+			errs.add(k.Line, "%q must be katakana, as %q at line %d is hiragana", k.Rune, e.Rune, e.Line)
+			continue
+		}
+		h, kk := *e, *k
+		h.Line, h.Rune, h.KanaType = 0, 0, 0
+		kk.Line, kk.Rune, kk.KanaType = 0, 0, 0
+		if h != kk {
+			errs.add(k.Line, "%q must have the same attributes as %q at line %d except the kana type", k.Rune, e.Rune, e.Line)
+		}
+	}
+	return errs.Err()
+}
+
+// ValidateOrders checks that the orders are contiguous from 1 in each class.
+func ValidateOrders(entries []*Entry) error {
+	var errs ErrorList
+	byClass := make(map[int][]*Entry)
+	for _, e := range entries {
+		byClass[e.Class] = append(byClass[e.Class], e)
+	}
+	for class, list := range byClass {
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].Order < list[j].Order
+		})
+		want := 1
+		for _, e := range list {
+			if e.Order > want {
+				errs.add(e.Line, "%s has no order %d, but has order %d", ClassNames[class], want, e.Order)
+			}
+			want = e.Order + 1
+		}
+	}
+	return errs.Err()
+}
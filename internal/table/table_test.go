@@ -0,0 +1,164 @@
+package table
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const header = "文字\t文字クラス\t番号\tダイアクリティカルマーク\t大小\t清濁\t記号種別\t仮名種別\n"
+
+func mustParse(t *testing.T, input string) []*Entry {
+	t.Helper()
+	entries, err := Parse(strings.NewReader(header + input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entries
+}
+
+// errorLines returns the line numbers of err, which must be an ErrorList.
+func errorLines(t *testing.T, err error) []int {
+	t.Helper()
+	var list ErrorList
+	if !errors.As(err, &list) {
+		t.Fatalf("want ErrorList, got %v", err)
+	}
+	lines := make([]int, 0, len(list))
+	for _, e := range list {
+		lines = append(lines, e.Line)
+	}
+	return lines
+}
+
+func equalLines(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestParse(t *testing.T) {
+	entries := mustParse(t, ""+
+		"あ\t仮名\t1\t\t\t清音\t大文字\t平仮名\n"+
+		"\t\t\t\t\t\t\t\n"+ // empty rows are skipped
+		"ヴ\t仮名\t3\t\t\t濁音\t大文字\t片仮名\r\n")
+	want := []Entry{
+		{Line: 2, Rune: 'あ', Class: 10, Order: 1, Voiced: 1, SymbolType: 4, KanaType: KanaTypeHiragana},
+		{Line: 4, Rune: 'ヴ', Class: 10, Order: 3, Voiced: 2, SymbolType: 4, KanaType: KanaTypeKatakana},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("want %d entries, got %d", len(want), len(entries))
+	}
+	for i, e := range entries {
+		if *e != want[i] {
+			t.Errorf("entries[%d] = %#v, want %#v", i, *e, want[i])
+		}
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	input := header +
+		"あ\t仮名\t1\t\t\t清音\t大文字\n" + // wrong number of fields
+		"い\t仮名\t2\t\t\t清音\t中文字\t平仮名\n" + // unknown symbol type
+		"う\t不明\t3\t\t\t清音\t大文字\t平仮名\n" + // unknown class
+		"え\t仮名\t0\t\t\t清音\t大文字\t平仮名\n" + // invalid order
+		"お\t仮名\t2147483648\t\t\t清音\t大文字\t平仮名\n" + // too large order
+		"かき\t仮名\t6\t\t\t清音\t大文字\t平仮名\n" // not a single character
+	_, err := Parse(strings.NewReader(input))
+	if got, want := errorLines(t, err), []int{2, 3, 4, 5, 6, 7}; !equalLines(got, want) {
+		t.Errorf("got errors at lines %v, want %v: %v", got, want, err)
+	}
+	if got, want := err.Error(), "line 2: wrong number of fields: want 8, got 7 (and 5 more errors)"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidateDuplicates(t *testing.T) {
+	entries := mustParse(t, ""+
+		"あ\t仮名\t1\t\t\t清音\t大文字\t平仮名\n"+
+		"い\t仮名\t2\t\t\t清音\t大文字\t平仮名\n"+
+		"あ\t仮名\t1\t\t\t清音\t大文字\t平仮名\n")
+	err := ValidateDuplicates(entries)
+	if got, want := errorLines(t, err), []int{4}; !equalLines(got, want) {
+		t.Errorf("got errors at lines %v, want %v: %v", got, want, err)
+	}
+	if got, want := err.Error(), `line 4: duplicated character 'あ', first defined at line 2`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if err := ValidateDuplicates(entries[:2]); err != nil {
+		t.Errorf("want no errors, got %v", err)
+	}
+}
+
+func TestValidateKanaPairs(t *testing.T) {
+	entries := mustParse(t, ""+
+		"ら\t仮名\t38\t\t\t清音\t大文字\t平仮名\n"+
+		"ラ\t仮名\t38\t\t\t清音\t大文字\t片仮名\n"+
+		"ろ\t仮名\t42\t\t\t清音\t大文字\t平仮名\n"+
+		"ロ\t仮名\t43\t\t\t清音\t大文字\t片仮名\n"+ // different order
+		"わ\t仮名\t44\t\t\t清音\t大文字\t平仮名\n"+
+		"ワ\t仮名\t44\t\t\t清音\t大文字\t平仮名\n") // not katakana
+	err := ValidateKanaPairs(entries)
+	if got, want := errorLines(t, err), []int{5, 7}; !equalLines(got, want) {
+		t.Errorf("got errors at lines %v, want %v: %v", got, want, err)
+	}
+
+	if err := ValidateKanaPairs(entries[:2]); err != nil {
+		t.Errorf("want no errors, got %v", err)
+	}
+}
+
+func TestValidateOrders(t *testing.T) {
+	entries := mustParse(t, ""+
+		"あ\t仮名\t1\t\t\t清音\t大文字\t平仮名\n"+
+		"ア\t仮名\t1\t\t\t清音\t大文字\t片仮名\n"+
+		"う\t仮名\t3\t\t\t清音\t大文字\t平仮名\n"+ // no order 2
+		"A\tラテンアルファベット\t1\t\t大文字\t\t\t\n"+
+		"B\tラテンアルファベット\t2\t\t大文字\t\t\t\n")
+	err := ValidateOrders(entries)
+	if got, want := errorLines(t, err), []int{4}; !equalLines(got, want) {
+		t.Errorf("got errors at lines %v, want %v: %v", got, want, err)
+	}
+	if got, want := err.Error(), "line 4: 仮名 has no order 2, but has order 3"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if err := ValidateOrders(entries[3:]); err != nil {
+		t.Errorf("want no errors, got %v", err)
+	}
+}
+
+func TestErrorList(t *testing.T) {
+	var list ErrorList
+	if err := list.Err(); err != nil {
+		t.Errorf("want nil, got %v", err)
+	}
+	if got, want := list.Error(), "no errors"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	list.add(5, "five")
+	list.add(2, "two")
+	list.add(5, "another five")
+	err := list.Err()
+	if got, want := errorLines(t, err), []int{2, 5, 5}; !equalLines(got, want) {
+		t.Errorf("got errors at lines %v, want %v", got, want)
+	}
+	// the errors at the same line keep their order.
+	if list[1].Msg != "five" || list[2].Msg != "another five" {
+		t.Errorf("the errors at line 5 are reordered: %v, %v", list[1], list[2])
+	}
+	if got, want := err.Error(), "line 2: two (and 2 more errors)"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := list[0].Error(), "line 2: two"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
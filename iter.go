@@ -0,0 +1,120 @@
+package jisx4061
+
+// iter iterates over the collation elements of a string.
+type iter struct {
+	c     *Collator
+	s     string
+	i     int  // the position in s
+	last  rune // the last rune, which is used for 長音記号 and 繰返し記号
+	last2 rune // the rune before the last, which is used for くの字点
+
+	// buf is the attributes that are expanded but not returned yet.
+	buf []attr
+
+	// tiebreak is the weights that are compared after all levels.
+	tiebreak []int
+}
+
+func (c *Collator) newIter(s string) *iter {
+	return &iter{
+		c: c,
+		s: s,
+	}
+}
+
+// next returns the next attribute.
+// It returns false if there is no more attribute.
+func (it *iter) next() (attr, bool) {
+	a, ok := it.nextAttr()
+	if ok && a.class == classKana && it.c.kanaOrder == KanaOrderIroha {
+		a.order = irohaOrder(a.order)
+	}
+	return a, ok
+}
+
+// nextAttr returns the next attribute in the order of the table.
+func (it *iter) nextAttr() (attr, bool) {
+	for {
+		if len(it.buf) > 0 {
+			a := it.buf[0]
+			it.buf = it.buf[1:]
+			return a, true
+		}
+		if it.i >= len(it.s) {
+			return attr{}, false
+		}
+
+		a, r, n := it.c.getAttr(it.s[it.i:], it.last)
+		if a.class == 0 {
+			// the rest of the string has only ignored runes.
+			it.i += n
+			return attr{}, false
+		}
+		if a.class == classCompat {
+			it.i += n
+			it.foldCompat(r)
+			continue
+		}
+		if isDoubleRepeatMark(r) {
+			it.i += n
+			it.pushDoubleRepeat(a)
+			continue
+		}
+		if a.class == classNumber {
+			if value, form, ok := numberForm(r); ok {
+				it.i += n
+				it.setLast(r)
+				it.pushNumberForm(value, form)
+				continue
+			}
+			if it.c.numeric {
+				it.readNumber()
+				continue
+			}
+		}
+		if a.class == classKanji && it.c.kanjiNumerals && isKanjiNumeral(r) {
+			it.readKanjiNumber()
+			continue
+		}
+		if a.class == classKanji && it.c.yomi != nil {
+			it.readKanji()
+			continue
+		}
+		it.i += n
+		it.setLast(r)
+		return a, true
+	}
+}
+
+// setLast records r as the last rune.
+func (it *iter) setLast(r rune) {
+	it.last2, it.last = it.last, r
+}
+
+// pushDoubleRepeat pushes the attributes of the last two kana repeated by くの字点 into the buffer.
+// mark is the attribute of the repeat mark, and its voicing applies to the first repeated kana.
+// If there are no kana to repeat, the mark is compared as ゝ.
+func (it *iter) pushDoubleRepeat(mark attr) {
+	var runes []rune
+	if a, ok := it.c.lookup(it.last); ok && a.class == classKana {
+		if a, ok := it.c.lookup(it.last2); ok && a.class == classKana {
+			runes = append(runes, it.last2)
+		}
+		runes = append(runes, it.last)
+	}
+	if len(runes) == 0 {
+		a, _ := it.c.lookup('ゝ')
+		a.voiced = mark.voiced
+		it.buf = append(it.buf, a)
+		return
+	}
+
+	for i, r := range runes {
+		a, _ := it.c.lookup(r)
+		a.symbolType = symbolTypeRepeat
+		if i == 0 && mark.voiced == voicedVoiced && a.voiced == voicedUnvoiced {
+			a.voiced = voicedVoiced
+		}
+		it.buf = append(it.buf, a)
+	}
+}
@@ -0,0 +1,1886 @@
+// Code generated by gen/main.go; DO NOT EDIT.
+
+package jisx4061
+
+// jisPositionCount is the number of positions in the two planes of JIS X 0213.
+const jisPositionCount = 17672
+
+// jisRunes is the sorted list of kanji in JIS X 0213.
+var jisRunes = [9976]rune{
+	0x3402, 0x3406, 0x342C, 0x342E, 0x3468, 0x346A, 0x3492, 0x34B5,
+	0x34BC, 0x34C1, 0x34C7, 0x34DB, 0x351F, 0x355D, 0x355E, 0x3563,
+	0x356E, 0x35A6, 0x35A8, 0x35C5, 0x35DA, 0x35F4, 0x3605, 0x364A,
+	0x3691, 0x3696, 0x3699, 0x36CF, 0x3761, 0x3762, 0x376B, 0x376C,
+	0x3775, 0x378D, 0x37C1, 0x37E2, 0x37E8, 0x37F4, 0x37FD, 0x3800,
+	0x382F, 0x3836, 0x3840, 0x385C, 0x3861, 0x38FA, 0x3917, 0x391A,
+	0x396F, 0x3A6E, 0x3A73, 0x3AD6, 0x3AD7, 0x3AEA, 0x3B0E, 0x3B1A,
+	0x3B1C, 0x3B22, 0x3B6D, 0x3B77, 0x3B87, 0x3B88, 0x3B8D, 0x3BA4,
+	0x3BB6, 0x3BC3, 0x3BCD, 0x3BF0, 0x3C0F, 0x3C26, 0x3CC3, 0x3CD2,
+	0x3D11, 0x3D1E, 0x3D64, 0x3D9A, 0x3DC0, 0x3DD4, 0x3E05, 0x3E3F,
+	0x3E60, 0x3E66, 0x3E68, 0x3E83, 0x3E94, 0x3F57, 0x3F72, 0x3F75,
+	0x3F77, 0x3FAE, 0x3FC9, 0x3FD7, 0x4039, 0x4058, 0x4093, 0x4105,
+	0x4148, 0x414F, 0x4163, 0x41B4, 0x41BF, 0x41E6, 0x41EE, 0x41F3,
+	0x4207, 0x420E, 0x4264, 0x42C6, 0x42D6, 0x42DD, 0x4302, 0x432B,
+	0x4343, 0x43EE, 0x43F0, 0x4408, 0x4417, 0x441C, 0x4422, 0x4453,
+	0x445B, 0x4476, 0x447A, 0x4491, 0x44B3, 0x44BE, 0x44D4, 0x4508,
+	0x450D, 0x4525, 0x4543, 0x459D, 0x45B8, 0x45E5, 0x45EA, 0x460F,
+	0x4641, 0x4665, 0x46A1, 0x46AF, 0x470C, 0x4764, 0x47FD, 0x4816,
+	0x4844, 0x484E, 0x48B5, 0x49B0, 0x49E7, 0x49FA, 0x4A04, 0x4A29,
+	0x4ABC, 0x4B3B, 0x4BC2, 0x4BCA, 0x4BD2, 0x4BE8, 0x4C17, 0x4C20,
+	0x4CC4, 0x4CD1, 0x4D07, 0x4D77, 0x4E00, 0x4E01, 0x4E02, 0x4E03,
+	0x4E07, 0x4E08, 0x4E09, 0x4E0A, 0x4E0B, 0x4E0D, 0x4E0E, 0x4E0F,
+	0x4E10, 0x4E11, 0x4E12, 0x4E14, 0x4E15, 0x4E16, 0x4E17, 0x4E18,
+	0x4E19, 0x4E1E, 0x4E21, 0x4E26, 0x4E28, 0x4E29, 0x4E2A, 0x4E2B,
+	0x4E2C, 0x4E2D, 0x4E2E, 0x4E2F, 0x4E30, 0x4E31, 0x4E32, 0x4E36,
+	0x4E38, 0x4E39, 0x4E3B, 0x4E3C, 0x4E3F, 0x4E40, 0x4E42, 0x4E43,
+	0x4E45, 0x4E47, 0x4E48, 0x4E4B, 0x4E4D, 0x4E4E, 0x4E4F, 0x4E51,
+	0x4E55, 0x4E56, 0x4E57, 0x4E58, 0x4E59, 0x4E5A, 0x4E5D, 0x4E5E,
+	0x4E5F, 0x4E62, 0x4E69, 0x4E71, 0x4E73, 0x4E7E, 0x4E80, 0x4E82,
+	0x4E85, 0x4E86, 0x4E88, 0x4E89, 0x4E8A, 0x4E8B, 0x4E8C, 0x4E8D,
+	0x4E8E, 0x4E91, 0x4E92, 0x4E94, 0x4E95, 0x4E98, 0x4E99, 0x4E9B,
+	0x4E9C, 0x4E9D, 0x4E9E, 0x4E9F, 0x4EA0, 0x4EA1, 0x4EA2, 0x4EA4,
+	0x4EA5, 0x4EA6, 0x4EA8, 0x4EAB, 0x4EAC, 0x4EAD, 0x4EAE, 0x4EB0,
+	0x4EB3, 0x4EB6, 0x4EB9, 0x4EBA, 0x4EBB, 0x4EBC, 0x4EC0, 0x4EC1,
+	0x4EC2, 0x4EC3, 0x4EC4, 0x4EC6, 0x4EC7, 0x4EC8, 0x4ECA, 0x4ECB,
+	0x4ECD, 0x4ECE, 0x4ECF, 0x4ED0, 0x4ED4, 0x4ED5, 0x4ED6, 0x4ED7,
+	0x4ED8, 0x4ED9, 0x4EDA, 0x4EDD, 0x4EDE, 0x4EDF, 0x4EE1, 0x4EE3,
+	0x4EE4, 0x4EE5, 0x4EEB, 0x4EED, 0x4EEE, 0x4EF0, 0x4EF1, 0x4EF2,
+	0x4EF5, 0x4EF6, 0x4EF7, 0x4EFB, 0x4EFD, 0x4EFF, 0x4F00, 0x4F01,
+	0x4F03, 0x4F09, 0x4F0A, 0x4F0B, 0x4F0D, 0x4F0E, 0x4F0F, 0x4F10,
+	0x4F11, 0x4F16, 0x4F1A, 0x4F1C, 0x4F1D, 0x4F2F, 0x4F30, 0x4F34,
+	0x4F36, 0x4F37, 0x4F38, 0x4F3A, 0x4F3C, 0x4F3D, 0x4F3E, 0x4F43,
+	0x4F46, 0x4F47, 0x4F48, 0x4F49, 0x4F4D, 0x4F4E, 0x4F4F, 0x4F50,
+	0x4F51, 0x4F53, 0x4F54, 0x4F55, 0x4F56, 0x4F57, 0x4F58, 0x4F59,
+	0x4F5A, 0x4F5B, 0x4F5C, 0x4F5D, 0x4F5E, 0x4F5F, 0x4F60, 0x4F64,
+	0x4F69, 0x4F6A, 0x4F6C, 0x4F6F, 0x4F70, 0x4F73, 0x4F75, 0x4F76,
+	0x4F77, 0x4F78, 0x4F7A, 0x4F7B, 0x4F7C, 0x4F7D, 0x4F7E, 0x4F7F,
+	0x4F82, 0x4F83, 0x4F85, 0x4F86, 0x4F88, 0x4F8A, 0x4F8B, 0x4F8D,
+	0x4F8F, 0x4F91, 0x4F92, 0x4F94, 0x4F96, 0x4F97, 0x4F98, 0x4F9A,
+	0x4F9B, 0x4F9D, 0x4FA0, 0x4FA1, 0x4FAB, 0x4FAD, 0x4FAE, 0x4FAF,
+	0x4FB2, 0x4FB5, 0x4FB6, 0x4FBE, 0x4FBF, 0x4FC2, 0x4FC3, 0x4FC4,
+	0x4FC5, 0x4FC9, 0x4FCA, 0x4FCB, 0x4FCE, 0x4FCF, 0x4FD0, 0x4FD1,
+	0x4FD2, 0x4FD4, 0x4FD7, 0x4FD8, 0x4FDA, 0x4FDB, 0x4FDD, 0x4FDF,
+	0x4FE0, 0x4FE1, 0x4FE3, 0x4FE4, 0x4FE5, 0x4FE6, 0x4FEE, 0x4FEF,
+	0x4FF1, 0x4FF2, 0x4FF3, 0x4FF5, 0x4FF6, 0x4FF8, 0x4FFA, 0x4FFE,
+	0x5000, 0x5001, 0x5002, 0x5005, 0x5006, 0x5009, 0x500B, 0x500D,
+	0x500E, 0x500F, 0x5010, 0x5011, 0x5012, 0x5013, 0x5014, 0x5016,
+	0x5018, 0x5019, 0x501A, 0x501C, 0x501E, 0x501F, 0x5021, 0x5022,
+	0x5023, 0x5024, 0x5025, 0x5026, 0x5027, 0x5028, 0x5029, 0x502A,
+	0x502B, 0x502C, 0x502D, 0x502E, 0x5036, 0x5039, 0x503B, 0x5040,
+	0x5041, 0x5042, 0x5043, 0x5046, 0x5047, 0x5048, 0x5049, 0x504E,
+	0x504F, 0x5050, 0x5053, 0x5055, 0x5056, 0x5057, 0x505A, 0x505C,
+	0x5063, 0x5065, 0x5066, 0x506A, 0x506C, 0x5070, 0x5072, 0x5074,
+	0x5075, 0x5076, 0x5078, 0x507D, 0x5080, 0x5085, 0x5088, 0x508D,
+	0x5091, 0x5092, 0x5093, 0x5094, 0x5095, 0x5096, 0x5098, 0x5099,
+	0x509A, 0x509C, 0x50A3, 0x50AA, 0x50AC, 0x50AD, 0x50B1, 0x50B2,
+	0x50B3, 0x50B4, 0x50B5, 0x50B7, 0x50BA, 0x50BB, 0x50BE, 0x50C2,
+	0x50C4, 0x50C5, 0x50C7, 0x50C9, 0x50CA, 0x50CC, 0x50CD, 0x50CE,
+	0x50CF, 0x50D0, 0x50D1, 0x50D4, 0x50D5, 0x50D6, 0x50D9, 0x50DA,
+	0x50DE, 0x50E1, 0x50E3, 0x50E5, 0x50E6, 0x50E7, 0x50E9, 0x50ED,
+	0x50EE, 0x50F2, 0x50F3, 0x50F5, 0x50F9, 0x50FB, 0x5100, 0x5101,
+	0x5102, 0x5103, 0x5104, 0x5106, 0x5108, 0x5109, 0x510B, 0x5112,
+	0x5114, 0x5115, 0x5116, 0x5117, 0x5118, 0x511A, 0x511B, 0x511E,
+	0x511F, 0x5121, 0x512A, 0x5132, 0x5135, 0x5137, 0x513A, 0x513B,
+	0x513C, 0x513F, 0x5140, 0x5141, 0x5143, 0x5144, 0x5145, 0x5146,
+	0x5147, 0x5148, 0x5149, 0x514A, 0x514B, 0x514C, 0x514D, 0x514E,
+	0x5150, 0x5152, 0x5154, 0x5155, 0x5157, 0x515A, 0x515C, 0x5160,
+	0x5162, 0x5165, 0x5168, 0x5169, 0x516A, 0x516B, 0x516C, 0x516D,
+	0x516E, 0x5171, 0x5173, 0x5175, 0x5176, 0x5177, 0x5178, 0x517B,
+	0x517C, 0x5180, 0x5182, 0x5183, 0x5185, 0x5186, 0x5189, 0x518A,
+	0x518B, 0x518C, 0x518D, 0x518F, 0x5190, 0x5191, 0x5192, 0x5193,
+	0x5195, 0x5196, 0x5197, 0x5198, 0x5199, 0x519D, 0x51A0, 0x51A2,
+	0x51A3, 0x51A4, 0x51A5, 0x51A6, 0x51A8, 0x51A9, 0x51AA, 0x51AB,
+	0x51AC, 0x51AD, 0x51B0, 0x51B1, 0x51B2, 0x51B3, 0x51B4, 0x51B5,
+	0x51B6, 0x51B7, 0x51BC, 0x51BD, 0x51C3, 0x51C4, 0x51C5, 0x51C6,
+	0x51C9, 0x51CA, 0x51CB, 0x51CC, 0x51CD, 0x51D6, 0x51DB, 0x51DC,
+	0x51DD, 0x51DE, 0x51E0, 0x51E1, 0x51E2, 0x51E6, 0x51E7, 0x51E9,
+	0x51EA, 0x51ED, 0x51EE, 0x51F0, 0x51F1, 0x51F3, 0x51F4, 0x51F5,
+	0x51F6, 0x51F8, 0x51F9, 0x51FA, 0x51FD, 0x51FE, 0x5200, 0x5201,
+	0x5202, 0x5203, 0x5204, 0x5206, 0x5207, 0x5208, 0x520A, 0x520B,
+	0x520E, 0x5211, 0x5212, 0x5213, 0x5214, 0x5215, 0x5216, 0x5217,
+	0x521D, 0x5224, 0x5225, 0x5227, 0x5229, 0x522A, 0x522E, 0x5230,
+	0x5233, 0x5236, 0x5237, 0x5238, 0x5239, 0x523A, 0x523B, 0x5243,
+	0x5244, 0x5247, 0x5249, 0x524A, 0x524B, 0x524C, 0x524D, 0x524F,
+	0x5254, 0x5255, 0x5256, 0x5257, 0x525B, 0x525C, 0x525D, 0x525E,
+	0x5261, 0x5263, 0x5264, 0x5265, 0x5269, 0x526A, 0x526C, 0x526F,
+	0x5270, 0x5271, 0x5272, 0x5273, 0x5274, 0x5275, 0x5277, 0x527D,
+	0x527F, 0x5282, 0x5283, 0x5284, 0x5287, 0x5288, 0x5289, 0x528D,
+	0x5291, 0x5292, 0x5293, 0x5294, 0x5298, 0x529B, 0x529F, 0x52A0,
+	0x52A3, 0x52A4, 0x52A6, 0x52A9, 0x52AA, 0x52AB, 0x52AC, 0x52AD,
+	0x52AF, 0x52B1, 0x52B4, 0x52B5, 0x52B9, 0x52BA, 0x52BB, 0x52BC,
+	0x52BE, 0x52C1, 0x52C3, 0x52C5, 0x52C7, 0x52C8, 0x52C9, 0x52CA,
+	0x52CC, 0x52CD, 0x52D0, 0x52D1, 0x52D2, 0x52D5, 0x52D6, 0x52D7,
+	0x52D8, 0x52D9, 0x52DB, 0x52DD, 0x52DE, 0x52DF, 0x52E0, 0x52E2,
+	0x52E3, 0x52E4, 0x52E6, 0x52E7, 0x52F0, 0x52F2, 0x52F3, 0x52F5,
+	0x52F7, 0x52F8, 0x52F9, 0x52FA, 0x52FB, 0x52FE, 0x52FF, 0x5300,
+	0x5301, 0x5302, 0x5305, 0x5306, 0x5307, 0x5308, 0x530A, 0x530B,
+	0x530D, 0x530F, 0x5310, 0x5315, 0x5316, 0x5317, 0x5319, 0x531A,
+	0x531C, 0x531D, 0x5320, 0x5321, 0x5323, 0x5324, 0x532A, 0x532F,
+	0x5331, 0x5333, 0x5335, 0x5338, 0x5339, 0x533A, 0x533B, 0x533E,
+	0x533F, 0x5340, 0x5341, 0x5342, 0x5343, 0x5345, 0x5346, 0x5347,
+	0x5348, 0x5349, 0x534A, 0x534D, 0x5351, 0x5352, 0x5353, 0x5354,
+	0x5357, 0x5358, 0x535A, 0x535C, 0x535E, 0x5360, 0x5361, 0x5363,
+	0x5366, 0x5367, 0x5369, 0x536C, 0x536E, 0x536F, 0x5370, 0x5371,
+	0x5373, 0x5374, 0x5375, 0x5377, 0x5378, 0x537A, 0x537B, 0x537D,
+	0x537F, 0x5382, 0x5384, 0x5393, 0x5396, 0x5398, 0x539A, 0x539D,
+	0x539F, 0x53A0, 0x53A4, 0x53A5, 0x53A6, 0x53A8, 0x53A9, 0x53AD,
+	0x53AE, 0x53B0, 0x53B2, 0x53B3, 0x53B4, 0x53B6, 0x53B7, 0x53BB,
+	0x53C0, 0x53C2, 0x53C3, 0x53C8, 0x53C9, 0x53CA, 0x53CB, 0x53CC,
+	0x53CD, 0x53CE, 0x53D4, 0x53D5, 0x53D6, 0x53D7, 0x53D9, 0x53DA,
+	0x53DB, 0x53DF, 0x53E1, 0x53E2, 0x53E3, 0x53E4, 0x53E5, 0x53E8,
+	0x53E9, 0x53EA, 0x53EB, 0x53EC, 0x53ED, 0x53EE, 0x53EF, 0x53F0,
+	0x53F1, 0x53F2, 0x53F3, 0x53F4, 0x53F5, 0x53F6, 0x53F7, 0x53F8,
+	0x53FA, 0x5401, 0x5403, 0x5404, 0x5408, 0x5409, 0x540A, 0x540B,
+	0x540C, 0x540D, 0x540E, 0x540F, 0x5410, 0x5411, 0x5412, 0x541B,
+	0x541D, 0x541E, 0x541F, 0x5420, 0x5424, 0x5426, 0x5427, 0x5428,
+	0x5429, 0x542B, 0x542C, 0x542D, 0x542E, 0x5436, 0x5438, 0x5439,
+	0x543B, 0x543C, 0x543D, 0x543E, 0x5440, 0x5442, 0x5443, 0x5446,
+	0x5448, 0x5449, 0x544A, 0x544D, 0x544E, 0x5451, 0x5455, 0x545F,
+	0x5462, 0x5466, 0x5468, 0x546A, 0x546B, 0x546C, 0x5470, 0x5471,
+	0x5473, 0x5474, 0x5475, 0x5476, 0x5477, 0x547B, 0x547C, 0x547D,
+	0x547F, 0x5480, 0x5484, 0x5486, 0x5488, 0x548A, 0x548B, 0x548C,
+	0x548D, 0x548E, 0x548F, 0x5490, 0x5492, 0x5495, 0x5496, 0x549C,
+	0x54A0, 0x54A1, 0x54A2, 0x54A4, 0x54A5, 0x54A6, 0x54A8, 0x54A9,
+	0x54AB, 0x54AC, 0x54AD, 0x54AE, 0x54AF, 0x54B2, 0x54B3, 0x54B7,
+	0x54B8, 0x54BA, 0x54BC, 0x54BD, 0x54BE, 0x54BF, 0x54C0, 0x54C1,
+	0x54C2, 0x54C3, 0x54C4, 0x54C6, 0x54C7, 0x54C8, 0x54C9, 0x54D8,
+	0x54E1, 0x54E2, 0x54E5, 0x54E6, 0x54E8, 0x54E9, 0x54EC, 0x54ED,
+	0x54EE, 0x54EF, 0x54F1, 0x54F2, 0x54F3, 0x54FA, 0x54FD, 0x54FF,
+	0x5500, 0x5501, 0x5504, 0x5506, 0x5507, 0x5509, 0x550E, 0x550F,
+	0x5510, 0x5514, 0x5516, 0x552B, 0x552E, 0x552F, 0x5531, 0x5533,
+	0x5535, 0x5538, 0x5539, 0x553C, 0x553E, 0x5540, 0x5541, 0x5544,
+	0x5545, 0x5546, 0x5547, 0x554A, 0x554C, 0x554F, 0x5550, 0x5553,
+	0x5556, 0x5557, 0x555C, 0x555D, 0x555E, 0x5560, 0x5561, 0x5563,
+	0x5564, 0x557B, 0x557C, 0x557D, 0x557E, 0x5580, 0x5581, 0x5582,
+	0x5583, 0x5584, 0x5586, 0x5587, 0x5588, 0x5589, 0x558A, 0x558B,
+	0x558E, 0x5591, 0x5598, 0x5599, 0x559A, 0x559C, 0x559D, 0x559E,
+	0x559F, 0x55A7, 0x55A8, 0x55A9, 0x55AA, 0x55AB, 0x55AC, 0x55AD,
+	0x55AE, 0x55B0, 0x55B6, 0x55BF, 0x55C4, 0x55C5, 0x55C7, 0x55C9,
+	0x55CC, 0x55CE, 0x55D1, 0x55D2, 0x55D4, 0x55DA, 0x55DC, 0x55DD,
+	0x55DF, 0x55E2, 0x55E3, 0x55E4, 0x55E9, 0x55F7, 0x55F9, 0x55FD,
+	0x55FE, 0x5606, 0x5607, 0x5608, 0x5609, 0x560E, 0x5610, 0x5614,
+	0x5616, 0x5617, 0x5618, 0x561B, 0x5628, 0x5629, 0x562F, 0x5630,
+	0x5631, 0x5632, 0x5634, 0x5636, 0x5637, 0x5638, 0x563B, 0x563D,
+	0x563F, 0x5640, 0x5642, 0x5647, 0x5649, 0x564C, 0x564E, 0x5650,
+	0x5653, 0x565B, 0x565E, 0x5660, 0x5664, 0x5666, 0x5668, 0x566A,
+	0x566B, 0x566C, 0x566D, 0x566F, 0x5671, 0x5672, 0x5674, 0x5676,
+	0x5678, 0x567A, 0x5680, 0x5686, 0x5687, 0x5688, 0x568A, 0x568C,
+	0x568F, 0x5694, 0x5695, 0x5699, 0x569A, 0x569D, 0x569E, 0x56A0,
+	0x56A2, 0x56A5, 0x56A8, 0x56A9, 0x56AC, 0x56AD, 0x56AE, 0x56B2,
+	0x56B3, 0x56B4, 0x56B6, 0x56BC, 0x56C0, 0x56C1, 0x56C2, 0x56C3,
+	0x56C5, 0x56C8, 0x56C9, 0x56CA, 0x56CD, 0x56CE, 0x56D1, 0x56D3,
+	0x56D7, 0x56D8, 0x56DA, 0x56DB, 0x56DE, 0x56DF, 0x56E0, 0x56E3,
+	0x56E8, 0x56EE, 0x56F0, 0x56F2, 0x56F3, 0x56F6, 0x56F7, 0x56F9,
+	0x56FA, 0x56FD, 0x56FF, 0x5700, 0x5703, 0x5704, 0x5708, 0x5709,
+	0x570A, 0x570B, 0x570D, 0x570F, 0x5712, 0x5713, 0x5715, 0x5716,
+	0x5718, 0x571C, 0x571F, 0x5721, 0x5723, 0x5726, 0x5727, 0x5728,
+	0x5729, 0x572D, 0x572F, 0x5730, 0x5733, 0x5734, 0x5737, 0x5738,
+	0x573B, 0x5740, 0x5742, 0x5745, 0x5746, 0x5747, 0x574A, 0x574C,
+	0x574D, 0x574E, 0x574F, 0x5750, 0x5751, 0x5761, 0x5764, 0x5766,
+	0x5768, 0x5769, 0x576A, 0x576F, 0x5770, 0x5773, 0x5774, 0x5775,
+	0x5777, 0x577B, 0x577C, 0x577F, 0x5782, 0x5788, 0x5789, 0x578B,
+	0x5793, 0x579A, 0x579C, 0x579D, 0x579E, 0x57A0, 0x57A2, 0x57A3,
+	0x57A4, 0x57A8, 0x57AA, 0x57AC, 0x57B0, 0x57B3, 0x57B8, 0x57C0,
+	0x57C3, 0x57C6, 0x57C7, 0x57C8, 0x57CB, 0x57CC, 0x57CE, 0x57CF,
+	0x57D2, 0x57D3, 0x57D4, 0x57D6, 0x57D7, 0x57DC, 0x57DE, 0x57DF,
+	0x57E0, 0x57E3, 0x57E4, 0x57E6, 0x57ED, 0x57F0, 0x57F4, 0x57F5,
+	0x57F6, 0x57F7, 0x57F8, 0x57F9, 0x57FA, 0x57FB, 0x57FC, 0x57FD,
+	0x57FF, 0x5800, 0x5802, 0x5804, 0x5805, 0x5806, 0x5809, 0x580A,
+	0x580B, 0x5815, 0x5819, 0x581D, 0x581E, 0x5820, 0x5821, 0x5824,
+	0x5827, 0x582A, 0x582F, 0x5830, 0x5831, 0x5832, 0x5834, 0x5835,
+	0x5839, 0x583A, 0x583D, 0x5840, 0x5841, 0x5849, 0x584A, 0x584B,
+	0x584C, 0x5851, 0x5852, 0x5854, 0x5857, 0x5858, 0x5859, 0x585A,
+	0x585E, 0x5861, 0x5862, 0x5864, 0x5867, 0x5869, 0x586B, 0x5870,
+	0x5872, 0x5875, 0x5879, 0x587C, 0x587E, 0x5883, 0x5885, 0x5889,
+	0x588A, 0x588B, 0x588D, 0x588F, 0x5890, 0x5893, 0x5894, 0x5897,
+	0x589C, 0x589D, 0x589E, 0x589F, 0x58A8, 0x58A9, 0x58AA, 0x58AB,
+	0x58AE, 0x58B1, 0x58B3, 0x58B8, 0x58B9, 0x58BA, 0x58BB, 0x58BE,
+	0x58C1, 0x58C3, 0x58C5, 0x58C7, 0x58CA, 0x58CC, 0x58CD, 0x58CE,
+	0x58D1, 0x58D2, 0x58D3, 0x58D4, 0x58D5, 0x58D7, 0x58D8, 0x58D9,
+	0x58DA, 0x58DC, 0x58DE, 0x58DF, 0x58E0, 0x58E2, 0x58E4, 0x58E5,
+	0x58E9, 0x58EB, 0x58EC, 0x58EE, 0x58EF, 0x58F0, 0x58F1, 0x58F2,
+	0x58F3, 0x58F4, 0x58F7, 0x58F9, 0x58FA, 0x58FB, 0x58FC, 0x58FD,
+	0x5902, 0x5905, 0x5906, 0x5909, 0x590A, 0x590B, 0x590C, 0x590D,
+	0x590F, 0x5910, 0x5914, 0x5915, 0x5916, 0x5918, 0x5919, 0x591A,
+	0x591B, 0x591C, 0x5922, 0x5924, 0x5925, 0x5927, 0x5929, 0x592A,
+	0x592B, 0x592C, 0x592D, 0x592E, 0x5931, 0x5932, 0x5937, 0x5938,
+	0x593D, 0x593E, 0x5944, 0x5946, 0x5947, 0x5948, 0x5949, 0x594E,
+	0x594F, 0x5950, 0x5951, 0x5954, 0x5955, 0x5957, 0x5958, 0x595A,
+	0x595B, 0x595D, 0x595F, 0x5960, 0x5962, 0x5965, 0x5967, 0x5968,
+	0x5969, 0x596A, 0x596C, 0x596D, 0x596E, 0x5973, 0x5974, 0x5975,
+	0x5976, 0x5978, 0x597C, 0x597D, 0x5981, 0x5982, 0x5983, 0x5984,
+	0x598A, 0x598B, 0x598D, 0x5992, 0x5993, 0x5996, 0x5999, 0x599B,
+	0x599D, 0x599F, 0x59A3, 0x59A4, 0x59A5, 0x59A8, 0x59AC, 0x59AE,
+	0x59B2, 0x59B9, 0x59BB, 0x59BC, 0x59BE, 0x59C3, 0x59C6, 0x59C8,
+	0x59C9, 0x59CB, 0x59CD, 0x59D0, 0x59D1, 0x59D2, 0x59D3, 0x59D4,
+	0x59D9, 0x59DA, 0x59DC, 0x59DD, 0x59DE, 0x59E3, 0x59E4, 0x59E5,
+	0x59E6, 0x59E7, 0x59E8, 0x59EA, 0x59EB, 0x59EE, 0x59F6, 0x59F8,
+	0x59FB, 0x59FF, 0x5A01, 0x5A03, 0x5A09, 0x5A0C, 0x5A0D, 0x5A11,
+	0x5A13, 0x5A17, 0x5A18, 0x5A1A, 0x5A1C, 0x5A1F, 0x5A20, 0x5A23,
+	0x5A25, 0x5A27, 0x5A29, 0x5A2D, 0x5A2F, 0x5A35, 0x5A36, 0x5A3C,
+	0x5A40, 0x5A41, 0x5A46, 0x5A49, 0x5A55, 0x5A5A, 0x5A62, 0x5A65,
+	0x5A66, 0x5A67, 0x5A6A, 0x5A6C, 0x5A6D, 0x5A77, 0x5A7A, 0x5A7E,
+	0x5A7F, 0x5A84, 0x5A8B, 0x5A92, 0x5A9A, 0x5A9B, 0x5A9C, 0x5A9E,
+	0x5A9F, 0x5AA0, 0x5AA2, 0x5AA7, 0x5AB1, 0x5AB3, 0x5AB5, 0x5ABA,
+	0x5ABC, 0x5ABD, 0x5ABE, 0x5ABF, 0x5AC1, 0x5AC2, 0x5AC4, 0x5AC9,
+	0x5ACB, 0x5ACC, 0x5AD0, 0x5AD6, 0x5AD7, 0x5ADA, 0x5ADC, 0x5AE0,
+	0x5AE1, 0x5AE3, 0x5AE5, 0x5AE6, 0x5AE9, 0x5AEE, 0x5AF0, 0x5AF5,
+	0x5AFA, 0x5AFB, 0x5B00, 0x5B08, 0x5B09, 0x5B0B, 0x5B0C, 0x5B16,
+	0x5B17, 0x5B19, 0x5B22, 0x5B25, 0x5B2A, 0x5B2C, 0x5B2D, 0x5B30,
+	0x5B32, 0x5B34, 0x5B36, 0x5B3E, 0x5B40, 0x5B41, 0x5B43, 0x5B45,
+	0x5B4C, 0x5B50, 0x5B51, 0x5B52, 0x5B54, 0x5B55, 0x5B56, 0x5B57,
+	0x5B58, 0x5B5A, 0x5B5B, 0x5B5C, 0x5B5D, 0x5B5F, 0x5B63, 0x5B64,
+	0x5B65, 0x5B66, 0x5B68, 0x5B69, 0x5B6B, 0x5B6F, 0x5B70, 0x5B71,
+	0x5B73, 0x5B75, 0x5B78, 0x5B7A, 0x5B7C, 0x5B7D, 0x5B7F, 0x5B80,
+	0x5B81, 0x5B83, 0x5B84, 0x5B85, 0x5B87, 0x5B88, 0x5B89, 0x5B8B,
+	0x5B8C, 0x5B8D, 0x5B8F, 0x5B93, 0x5B95, 0x5B96, 0x5B97, 0x5B98,
+	0x5B99, 0x5B9A, 0x5B9B, 0x5B9C, 0x5B9D, 0x5B9F, 0x5BA2, 0x5BA3,
+	0x5BA4, 0x5BA5, 0x5BA6, 0x5BAC, 0x5BAE, 0x5BB0, 0x5BB3, 0x5BB4,
+	0x5BB5, 0x5BB6, 0x5BB8, 0x5BB9, 0x5BBF, 0x5BC0, 0x5BC2, 0x5BC3,
+	0x5BC4, 0x5BC5, 0x5BC6, 0x5BC7, 0x5BC9, 0x5BCC, 0x5BCE, 0x5BD0,
+	0x5BD2, 0x5BD3, 0x5BD4, 0x5BD6, 0x5BD8, 0x5BDB, 0x5BDD, 0x5BDE,
+	0x5BDF, 0x5BE1, 0x5BE2, 0x5BE4, 0x5BE5, 0x5BE6, 0x5BE7, 0x5BE8,
+	0x5BE9, 0x5BEB, 0x5BEC, 0x5BEE, 0x5BF0, 0x5BF1, 0x5BF3, 0x5BF5,
+	0x5BF6, 0x5BF8, 0x5BFA, 0x5BFD, 0x5BFE, 0x5BFF, 0x5C01, 0x5C02,
+	0x5C03, 0x5C04, 0x5C05, 0x5C06, 0x5C07, 0x5C08, 0x5C09, 0x5C0A,
+	0x5C0B, 0x5C0D, 0x5C0E, 0x5C0F, 0x5C11, 0x5C12, 0x5C13, 0x5C16,
+	0x5C1A, 0x5C1E, 0x5C20, 0x5C22, 0x5C23, 0x5C24, 0x5C28, 0x5C29,
+	0x5C2B, 0x5C2D, 0x5C30, 0x5C31, 0x5C38, 0x5C39, 0x5C3A, 0x5C3B,
+	0x5C3C, 0x5C3D, 0x5C3E, 0x5C3F, 0x5C40, 0x5C41, 0x5C45, 0x5C46,
+	0x5C48, 0x5C4A, 0x5C4B, 0x5C4D, 0x5C4E, 0x5C4F, 0x5C50, 0x5C51,
+	0x5C53, 0x5C55, 0x5C5B, 0x5C5E, 0x5C5F, 0x5C60, 0x5C61, 0x5C62,
+	0x5C63, 0x5C64, 0x5C65, 0x5C67, 0x5C68, 0x5C69, 0x5C6C, 0x5C6E,
+	0x5C6F, 0x5C70, 0x5C71, 0x5C76, 0x5C79, 0x5C7A, 0x5C7C, 0x5C88,
+	0x5C8A, 0x5C8C, 0x5C8F, 0x5C90, 0x5C91, 0x5C94, 0x5C9F, 0x5CA0,
+	0x5CA1, 0x5CA2, 0x5CA3, 0x5CA6, 0x5CA7, 0x5CA8, 0x5CA9, 0x5CAA,
+	0x5CAB, 0x5CAC, 0x5CAD, 0x5CB1, 0x5CB3, 0x5CB5, 0x5CB6, 0x5CB7,
+	0x5CB8, 0x5CBA, 0x5CBB, 0x5CBC, 0x5CBE, 0x5CC5, 0x5CC7, 0x5CC9,
+	0x5CCB, 0x5CD0, 0x5CD2, 0x5CD9, 0x5CE0, 0x5CE1, 0x5CE8, 0x5CE9,
+	0x5CEA, 0x5CED, 0x5CEF, 0x5CF0, 0x5CF4, 0x5CF6, 0x5CFA, 0x5CFB,
+	0x5CFD, 0x5D06, 0x5D07, 0x5D0B, 0x5D0D, 0x5D0E, 0x5D10, 0x5D11,
+	0x5D14, 0x5D15, 0x5D16, 0x5D17, 0x5D18, 0x5D19, 0x5D1A, 0x5D1B,
+	0x5D1D, 0x5D1F, 0x5D20, 0x5D22, 0x5D24, 0x5D26, 0x5D27, 0x5D29,
+	0x5D2B, 0x5D31, 0x5D39, 0x5D42, 0x5D46, 0x5D47, 0x5D4A, 0x5D4B,
+	0x5D4C, 0x5D4E, 0x5D50, 0x5D52, 0x5D53, 0x5D5C, 0x5D61, 0x5D69,
+	0x5D6A, 0x5D6C, 0x5D6D, 0x5D6F, 0x5D70, 0x5D73, 0x5D76, 0x5D81,
+	0x5D82, 0x5D84, 0x5D87, 0x5D88, 0x5D8B, 0x5D8C, 0x5D90, 0x5D92,
+	0x5D94, 0x5D97, 0x5D99, 0x5D9D, 0x5DA0, 0x5DA2, 0x5DA4, 0x5DA7,
+	0x5DAC, 0x5DAE, 0x5DB0, 0x5DB2, 0x5DB4, 0x5DB7, 0x5DB8, 0x5DB9,
+	0x5DBA, 0x5DBC, 0x5DBD, 0x5DC9, 0x5DCB, 0x5DCC, 0x5DCD, 0x5DD1,
+	0x5DD2, 0x5DD3, 0x5DD6, 0x5DD7, 0x5DD8, 0x5DDB, 0x5DDD, 0x5DDE,
+	0x5DE0, 0x5DE1, 0x5DE2, 0x5DE3, 0x5DE4, 0x5DE5, 0x5DE6, 0x5DE7,
+	0x5DE8, 0x5DE9, 0x5DEB, 0x5DEE, 0x5DF1, 0x5DF2, 0x5DF3, 0x5DF4,
+	0x5DF5, 0x5DF7, 0x5DFB, 0x5DFD, 0x5DFE, 0x5E00, 0x5E02, 0x5E03,
+	0x5E06, 0x5E0B, 0x5E0C, 0x5E11, 0x5E12, 0x5E14, 0x5E15, 0x5E16,
+	0x5E18, 0x5E19, 0x5E1A, 0x5E1B, 0x5E1D, 0x5E1F, 0x5E25, 0x5E2B,
+	0x5E2D, 0x5E2E, 0x5E2F, 0x5E30, 0x5E33, 0x5E36, 0x5E37, 0x5E38,
+	0x5E3D, 0x5E3E, 0x5E40, 0x5E43, 0x5E44, 0x5E45, 0x5E47, 0x5E49,
+	0x5E4C, 0x5E4E, 0x5E54, 0x5E55, 0x5E56, 0x5E57, 0x5E58, 0x5E5E,
+	0x5E5F, 0x5E61, 0x5E62, 0x5E63, 0x5E64, 0x5E6B, 0x5E6C, 0x5E6D,
+	0x5E6E, 0x5E72, 0x5E73, 0x5E74, 0x5E75, 0x5E76, 0x5E77, 0x5E78,
+	0x5E79, 0x5E7A, 0x5E7B, 0x5E7C, 0x5E7D, 0x5E7E, 0x5E7F, 0x5E81,
+	0x5E83, 0x5E84, 0x5E87, 0x5E8A, 0x5E8F, 0x5E95, 0x5E96, 0x5E97,
+	0x5E9A, 0x5E9C, 0x5EA0, 0x5EA5, 0x5EA6, 0x5EA7, 0x5EAA, 0x5EAB,
+	0x5EAC, 0x5EAD, 0x5EB5, 0x5EB6, 0x5EB7, 0x5EB8, 0x5EB9, 0x5EBE,
+	0x5EBF, 0x5EC1, 0x5EC2, 0x5EC3, 0x5EC6, 0x5EC8, 0x5EC9, 0x5ECA,
+	0x5ECB, 0x5ECF, 0x5ED0, 0x5ED2, 0x5ED3, 0x5ED6, 0x5ED9, 0x5EDA,
+	0x5EDB, 0x5EDD, 0x5EDF, 0x5EE0, 0x5EE1, 0x5EE2, 0x5EE3, 0x5EE8,
+	0x5EE9, 0x5EEC, 0x5EF0, 0x5EF1, 0x5EF3, 0x5EF4, 0x5EF6, 0x5EF7,
+	0x5EF8, 0x5EF9, 0x5EFA, 0x5EFB, 0x5EFC, 0x5EFD, 0x5EFE, 0x5EFF,
+	0x5F00, 0x5F01, 0x5F02, 0x5F03, 0x5F04, 0x5F07, 0x5F08, 0x5F09,
+	0x5F0A, 0x5F0B, 0x5F0C, 0x5F0D, 0x5F0E, 0x5F0F, 0x5F10, 0x5F11,
+	0x5F13, 0x5F14, 0x5F15, 0x5F16, 0x5F17, 0x5F18, 0x5F1B, 0x5F1C,
+	0x5F1D, 0x5F1E, 0x5F1F, 0x5F23, 0x5F25, 0x5F26, 0x5F27, 0x5F29,
+	0x5F2D, 0x5F2F, 0x5F31, 0x5F34, 0x5F35, 0x5F36, 0x5F37, 0x5F38,
+	0x5F3C, 0x5F3D, 0x5F3E, 0x5F40, 0x5F41, 0x5F45, 0x5F47, 0x5F48,
+	0x5F4A, 0x5F4C, 0x5F4E, 0x5F51, 0x5F53, 0x5F54, 0x5F56, 0x5F57,
+	0x5F58, 0x5F59, 0x5F5C, 0x5F5D, 0x5F61, 0x5F62, 0x5F63, 0x5F64,
+	0x5F66, 0x5F67, 0x5F69, 0x5F6A, 0x5F6B, 0x5F6C, 0x5F6D, 0x5F70,
+	0x5F71, 0x5F72, 0x5F73, 0x5F77, 0x5F79, 0x5F7C, 0x5F7D, 0x5F7E,
+	0x5F7F, 0x5F80, 0x5F81, 0x5F82, 0x5F83, 0x5F84, 0x5F85, 0x5F87,
+	0x5F88, 0x5F89, 0x5F8A, 0x5F8B, 0x5F8C, 0x5F8F, 0x5F90, 0x5F91,
+	0x5F92, 0x5F93, 0x5F97, 0x5F98, 0x5F99, 0x5F9C, 0x5F9E, 0x5FA0,
+	0x5FA1, 0x5FA2, 0x5FA4, 0x5FA7, 0x5FA8, 0x5FA9, 0x5FAA, 0x5FAD,
+	0x5FAE, 0x5FAF, 0x5FB3, 0x5FB4, 0x5FB5, 0x5FB7, 0x5FB8, 0x5FB9,
+	0x5FBC, 0x5FBD, 0x5FC3, 0x5FC4, 0x5FC5, 0x5FC7, 0x5FC9, 0x5FCB,
+	0x5FCC, 0x5FCD, 0x5FD2, 0x5FD3, 0x5FD4, 0x5FD6, 0x5FD7, 0x5FD8,
+	0x5FD9, 0x5FDC, 0x5FDD, 0x5FDE, 0x5FE0, 0x5FE1, 0x5FE2, 0x5FE4,
+	0x5FE9, 0x5FEB, 0x5FEE, 0x5FEF, 0x5FF0, 0x5FF1, 0x5FF3, 0x5FF5,
+	0x5FF8, 0x5FFB, 0x5FFC, 0x5FFD, 0x5FFF, 0x600D, 0x600E, 0x600F,
+	0x6010, 0x6012, 0x6014, 0x6015, 0x6016, 0x6017, 0x6018, 0x6019,
+	0x601B, 0x601C, 0x601D, 0x6020, 0x6021, 0x6022, 0x6024, 0x6025,
+	0x6026, 0x6027, 0x6028, 0x6029, 0x602A, 0x602B, 0x602F, 0x6031,
+	0x6033, 0x6035, 0x603A, 0x6041, 0x6042, 0x6043, 0x6046, 0x6047,
+	0x604A, 0x604B, 0x604C, 0x604D, 0x6050, 0x6052, 0x6055, 0x6059,
+	0x605A, 0x605F, 0x6060, 0x6062, 0x6063, 0x6064, 0x6065, 0x6068,
+	0x6069, 0x606A, 0x606B, 0x606C, 0x606D, 0x606F, 0x6070, 0x6075,
+	0x6077, 0x607F, 0x6081, 0x6083, 0x6084, 0x6089, 0x608A, 0x608B,
+	0x608C, 0x608D, 0x6092, 0x6094, 0x6095, 0x6096, 0x6097, 0x609A,
+	0x609B, 0x609D, 0x609E, 0x609F, 0x60A0, 0x60A3, 0x60A6, 0x60A7,
+	0x60A8, 0x60A9, 0x60AA, 0x60B0, 0x60B1, 0x60B2, 0x60B3, 0x60B4,
+	0x60B5, 0x60B6, 0x60B8, 0x60BC, 0x60BD, 0x60BE, 0x60C5, 0x60C6,
+	0x60C7, 0x60C8, 0x60CB, 0x60D1, 0x60D3, 0x60D4, 0x60D5, 0x60D8,
+	0x60D9, 0x60DA, 0x60DB, 0x60DC, 0x60DD, 0x60DF, 0x60E0, 0x60E1,
+	0x60E3, 0x60E7, 0x60E8, 0x60EE, 0x60F0, 0x60F1, 0x60F2, 0x60F3,
+	0x60F4, 0x60F5, 0x60F6, 0x60F7, 0x60F8, 0x60F9, 0x60FA, 0x60FB,
+	0x6100, 0x6101, 0x6103, 0x6106, 0x6108, 0x6109, 0x610D, 0x610E,
+	0x610F, 0x6110, 0x6112, 0x6113, 0x6115, 0x6119, 0x611A, 0x611B,
+	0x611C, 0x611E, 0x611F, 0x6121, 0x6127, 0x6128, 0x612B, 0x612C,
+	0x6130, 0x6134, 0x6137, 0x613A, 0x613C, 0x613D, 0x613E, 0x613F,
+	0x6141, 0x6142, 0x6144, 0x6146, 0x6147, 0x6148, 0x614A, 0x614B,
+	0x614C, 0x614D, 0x614E, 0x6153, 0x6155, 0x6158, 0x6159, 0x615A,
+	0x615D, 0x615F, 0x6160, 0x6162, 0x6163, 0x6165, 0x6167, 0x6168,
+	0x616B, 0x616E, 0x616F, 0x6170, 0x6171, 0x6173, 0x6174, 0x6175,
+	0x6176, 0x6177, 0x617C, 0x617E, 0x6182, 0x6187, 0x618A, 0x618D,
+	0x618E, 0x6190, 0x6191, 0x6192, 0x6193, 0x6194, 0x6196, 0x6197,
+	0x6198, 0x6199, 0x619A, 0x61A4, 0x61A5, 0x61A7, 0x61A8, 0x61A9,
+	0x61AB, 0x61AC, 0x61AD, 0x61AE, 0x61B2, 0x61B6, 0x61B9, 0x61BA,
+	0x61BC, 0x61BE, 0x61C3, 0x61C6, 0x61C7, 0x61C8, 0x61C9, 0x61CA,
+	0x61CB, 0x61CC, 0x61CD, 0x61D0, 0x61D5, 0x61DD, 0x61DF, 0x61E3,
+	0x61E6, 0x61F2, 0x61F4, 0x61F5, 0x61F6, 0x61F7, 0x61F8, 0x61FA,
+	0x61FC, 0x61FD, 0x61FE, 0x61FF, 0x6200, 0x6208, 0x6209, 0x620A,
+	0x620C, 0x620D, 0x620E, 0x6210, 0x6211, 0x6212, 0x6214, 0x6215,
+	0x6216, 0x621A, 0x621B, 0x621D, 0x621E, 0x621F, 0x6221, 0x6222,
+	0x6223, 0x6226, 0x6229, 0x622A, 0x622E, 0x622F, 0x6230, 0x6232,
+	0x6233, 0x6234, 0x6238, 0x623B, 0x623E, 0x623F, 0x6240, 0x6241,
+	0x6243, 0x6246, 0x6247, 0x6248, 0x6249, 0x624B, 0x624C, 0x624D,
+	0x624E, 0x6251, 0x6252, 0x6253, 0x6255, 0x6256, 0x6258, 0x625A,
+	0x625B, 0x625E, 0x6260, 0x6261, 0x6263, 0x6264, 0x6268, 0x626D,
+	0x626E, 0x626F, 0x6271, 0x6273, 0x6276, 0x6279, 0x627B, 0x627C,
+	0x627E, 0x627F, 0x6280, 0x6282, 0x6283, 0x6284, 0x6285, 0x6289,
+	0x628A, 0x6291, 0x6292, 0x6293, 0x6294, 0x6295, 0x6296, 0x6297,
+	0x6298, 0x6299, 0x629B, 0x629C, 0x629E, 0x62A6, 0x62AB, 0x62AC,
+	0x62B1, 0x62B5, 0x62B9, 0x62BB, 0x62BC, 0x62BD, 0x62C2, 0x62C4,
+	0x62C5, 0x62C6, 0x62C7, 0x62C8, 0x62C9, 0x62CA, 0x62CC, 0x62CD,
+	0x62CF, 0x62D0, 0x62D1, 0x62D2, 0x62D3, 0x62D4, 0x62D5, 0x62D6,
+	0x62D7, 0x62D8, 0x62D9, 0x62DB, 0x62DC, 0x62DD, 0x62E0, 0x62E1,
+	0x62EC, 0x62ED, 0x62EE, 0x62EF, 0x62F1, 0x62F3, 0x62F5, 0x62F6,
+	0x62F7, 0x62FC, 0x62FD, 0x62FE, 0x62FF, 0x6301, 0x6302, 0x6303,
+	0x6307, 0x6308, 0x6309, 0x630A, 0x630C, 0x630D, 0x6310, 0x6311,
+	0x6318, 0x6319, 0x631F, 0x6327, 0x6328, 0x632B, 0x632F, 0x6332,
+	0x6335, 0x6339, 0x633A, 0x633B, 0x633C, 0x633D, 0x633E, 0x633F,
+	0x6341, 0x6343, 0x6344, 0x6349, 0x634C, 0x634D, 0x634E, 0x634F,
+	0x6350, 0x6355, 0x6357, 0x6359, 0x635C, 0x6365, 0x6367, 0x6368,
+	0x6369, 0x636B, 0x636C, 0x636E, 0x6372, 0x6376, 0x6377, 0x637A,
+	0x637B, 0x637C, 0x6380, 0x6383, 0x6384, 0x6388, 0x6389, 0x638C,
+	0x638E, 0x638F, 0x6392, 0x6394, 0x6396, 0x6398, 0x6399, 0x639B,
+	0x639F, 0x63A0, 0x63A1, 0x63A2, 0x63A3, 0x63A5, 0x63A7, 0x63A8,
+	0x63A9, 0x63AA, 0x63AB, 0x63AC, 0x63B2, 0x63B4, 0x63B5, 0x63BB,
+	0x63BD, 0x63BE, 0x63C0, 0x63C3, 0x63C4, 0x63C6, 0x63C9, 0x63CF,
+	0x63D0, 0x63D2, 0x63D4, 0x63D5, 0x63D6, 0x63DA, 0x63DB, 0x63DC,
+	0x63E0, 0x63E1, 0x63E3, 0x63E5, 0x63E9, 0x63EB, 0x63EC, 0x63ED,
+	0x63EE, 0x63F2, 0x63F4, 0x63F5, 0x63F6, 0x63F7, 0x63FA, 0x6406,
+	0x6409, 0x640D, 0x640F, 0x6410, 0x6413, 0x6414, 0x6416, 0x6417,
+	0x641C, 0x641E, 0x6422, 0x6425, 0x6426, 0x6428, 0x6429, 0x642C,
+	0x642D, 0x642F, 0x6434, 0x6436, 0x643A, 0x643E, 0x6442, 0x644E,
+	0x6451, 0x6458, 0x645A, 0x645B, 0x645D, 0x6460, 0x6467, 0x6469,
+	0x646D, 0x646F, 0x6473, 0x6476, 0x6478, 0x6479, 0x647A, 0x647D,
+	0x6483, 0x6487, 0x6488, 0x6491, 0x6492, 0x6493, 0x6495, 0x649A,
+	0x649D, 0x649E, 0x649F, 0x64A4, 0x64A5, 0x64A9, 0x64AB, 0x64AD,
+	0x64AE, 0x64B0, 0x64B2, 0x64B9, 0x64BB, 0x64BC, 0x64BE, 0x64BF,
+	0x64C1, 0x64C2, 0x64C4, 0x64C5, 0x64C7, 0x64CA, 0x64CB, 0x64CC,
+	0x64CD, 0x64CE, 0x64D0, 0x64D2, 0x64D4, 0x64D5, 0x64D7, 0x64D8,
+	0x64DA, 0x64E0, 0x64E1, 0x64E2, 0x64E3, 0x64E4, 0x64E5, 0x64E6,
+	0x64E7, 0x64EC, 0x64EF, 0x64F1, 0x64F2, 0x64F4, 0x64F6, 0x64F7,
+	0x64FA, 0x64FB, 0x64FD, 0x64FE, 0x64FF, 0x6500, 0x6504, 0x6505,
+	0x650F, 0x6514, 0x6516, 0x6518, 0x651C, 0x651D, 0x651E, 0x6522,
+	0x6523, 0x6524, 0x6529, 0x652A, 0x652B, 0x652C, 0x652F, 0x6532,
+	0x6534, 0x6535, 0x6536, 0x6537, 0x6538, 0x6539, 0x653B, 0x653E,
+	0x653F, 0x6544, 0x6545, 0x6548, 0x654D, 0x654F, 0x6551, 0x6554,
+	0x6555, 0x6556, 0x6557, 0x6558, 0x6559, 0x655D, 0x655E, 0x6562,
+	0x6563, 0x6566, 0x6567, 0x656B, 0x656C, 0x6570, 0x6572, 0x6574,
+	0x6575, 0x6577, 0x6578, 0x657A, 0x6581, 0x6582, 0x6583, 0x6584,
+	0x6585, 0x6587, 0x6588, 0x6589, 0x658A, 0x658C, 0x658E, 0x6590,
+	0x6591, 0x6597, 0x6599, 0x659B, 0x659C, 0x659D, 0x659F, 0x65A1,
+	0x65A4, 0x65A5, 0x65A7, 0x65AB, 0x65AC, 0x65AD, 0x65AF, 0x65B0,
+	0x65B2, 0x65B5, 0x65B7, 0x65B8, 0x65B9, 0x65BC, 0x65BD, 0x65BF,
+	0x65C1, 0x65C2, 0x65C3, 0x65C4, 0x65C5, 0x65C6, 0x65C9, 0x65CB,
+	0x65CC, 0x65CF, 0x65D2, 0x65D4, 0x65D7, 0x65D9, 0x65DB, 0x65E0,
+	0x65E1, 0x65E2, 0x65E5, 0x65E6, 0x65E7, 0x65E8, 0x65E9, 0x65EC,
+	0x65ED, 0x65F1, 0x65F2, 0x65F9, 0x65FA, 0x65FB, 0x65FC, 0x6600,
+	0x6602, 0x6603, 0x6604, 0x6606, 0x6607, 0x6608, 0x6609, 0x660A,
+	0x660C, 0x660E, 0x660F, 0x6613, 0x6614, 0x6615, 0x661C, 0x661E,
+	0x661F, 0x6620, 0x6621, 0x6622, 0x6624, 0x6625, 0x6627, 0x6628,
+	0x662A, 0x662B, 0x662D, 0x662F, 0x6630, 0x6631, 0x6633, 0x6634,
+	0x6635, 0x6636, 0x663A, 0x663C, 0x663F, 0x6641, 0x6642, 0x6643,
+	0x6644, 0x6645, 0x6648, 0x6649, 0x664B, 0x664C, 0x664E, 0x664F,
+	0x6651, 0x6652, 0x6657, 0x6659, 0x665A, 0x665B, 0x665D, 0x665E,
+	0x665F, 0x6661, 0x6662, 0x6663, 0x6664, 0x6665, 0x6666, 0x6667,
+	0x6668, 0x6669, 0x666A, 0x666B, 0x666C, 0x666D, 0x666E, 0x666F,
+	0x6670, 0x6673, 0x6674, 0x6676, 0x6677, 0x6678, 0x667A, 0x667B,
+	0x6680, 0x6681, 0x6683, 0x6684, 0x6687, 0x6688, 0x6689, 0x668D,
+	0x668E, 0x6690, 0x6691, 0x6692, 0x6696, 0x6697, 0x6698, 0x6699,
+	0x669D, 0x66A0, 0x66A2, 0x66A6, 0x66AB, 0x66AD, 0x66AE, 0x66B1,
+	0x66B2, 0x66B4, 0x66B5, 0x66B8, 0x66B9, 0x66BB, 0x66BC, 0x66BE,
+	0x66BF, 0x66C1, 0x66C4, 0x66C6, 0x66C7, 0x66C8, 0x66C9, 0x66D6,
+	0x66D9, 0x66DA, 0x66DB, 0x66DC, 0x66DD, 0x66E0, 0x66E6, 0x66E8,
+	0x66E9, 0x66EC, 0x66F0, 0x66F2, 0x66F3, 0x66F4, 0x66F5, 0x66F7,
+	0x66F8, 0x66F9, 0x66FA, 0x66FB, 0x66FC, 0x66FD, 0x66FE, 0x66FF,
+	0x6700, 0x6701, 0x6703, 0x6705, 0x6708, 0x6709, 0x670B, 0x670D,
+	0x670F, 0x6712, 0x6713, 0x6714, 0x6715, 0x6716, 0x6717, 0x6719,
+	0x671B, 0x671D, 0x671E, 0x671F, 0x6726, 0x6727, 0x6728, 0x672A,
+	0x672B, 0x672C, 0x672D, 0x672E, 0x6731, 0x6733, 0x6734, 0x6736,
+	0x6737, 0x6738, 0x673A, 0x673D, 0x673F, 0x6741, 0x6746, 0x6747,
+	0x6748, 0x6749, 0x674C, 0x674D, 0x674E, 0x674F, 0x6750, 0x6751,
+	0x6753, 0x6754, 0x6756, 0x6759, 0x675C, 0x675D, 0x675E, 0x675F,
+	0x6760, 0x6761, 0x6762, 0x6763, 0x6764, 0x6765, 0x6766, 0x676A,
+	0x676D, 0x676F, 0x6770, 0x6771, 0x6772, 0x6773, 0x6774, 0x6775,
+	0x6776, 0x6777, 0x677B, 0x677C, 0x677E, 0x677F, 0x6781, 0x6785,
+	0x6787, 0x6789, 0x678B, 0x678C, 0x6790, 0x6792, 0x6793, 0x6795,
+	0x6797, 0x6798, 0x679A, 0x679B, 0x679C, 0x679D, 0x67A0, 0x67A1,
+	0x67A2, 0x67A6, 0x67A9, 0x67AF, 0x67B0, 0x67B2, 0x67B3, 0x67B4,
+	0x67B6, 0x67B7, 0x67B8, 0x67B9, 0x67BB, 0x67C0, 0x67C1, 0x67C3,
+	0x67C4, 0x67C6, 0x67C8, 0x67CA, 0x67CE, 0x67CF, 0x67D0, 0x67D1,
+	0x67D2, 0x67D3, 0x67D4, 0x67D7, 0x67D8, 0x67D9, 0x67DA, 0x67DB,
+	0x67DD, 0x67DE, 0x67E2, 0x67E4, 0x67E7, 0x67E9, 0x67EC, 0x67EE,
+	0x67EF, 0x67F0, 0x67F1, 0x67F3, 0x67F4, 0x67F5, 0x67F7, 0x67F9,
+	0x67FB, 0x67FC, 0x67FE, 0x67FF, 0x6801, 0x6802, 0x6803, 0x6804,
+	0x6810, 0x6813, 0x6816, 0x6817, 0x6818, 0x681D, 0x681E, 0x681F,
+	0x6821, 0x6822, 0x6829, 0x682A, 0x682B, 0x682C, 0x682D, 0x6831,
+	0x6832, 0x6833, 0x6834, 0x6838, 0x6839, 0x683B, 0x683C, 0x683D,
+	0x683E, 0x6840, 0x6841, 0x6842, 0x6843, 0x6844, 0x6845, 0x6846,
+	0x6848, 0x6849, 0x684C, 0x684D, 0x684E, 0x6850, 0x6851, 0x6852,
+	0x6853, 0x6854, 0x6855, 0x6857, 0x6859, 0x685B, 0x685C, 0x685D,
+	0x685F, 0x6863, 0x6867, 0x686B, 0x686E, 0x6872, 0x6874, 0x6875,
+	0x6876, 0x6877, 0x687A, 0x687C, 0x687E, 0x687F, 0x6881, 0x6882,
+	0x6883, 0x6885, 0x688D, 0x688F, 0x6890, 0x6893, 0x6894, 0x6896,
+	0x6897, 0x6898, 0x6899, 0x689A, 0x689B, 0x689C, 0x689D, 0x689F,
+	0x68A0, 0x68A2, 0x68A3, 0x68A5, 0x68A6, 0x68A7, 0x68A8, 0x68AA,
+	0x68AB, 0x68AD, 0x68AF, 0x68B0, 0x68B1, 0x68B2, 0x68B3, 0x68B4,
+	0x68B5, 0x68B6, 0x68B9, 0x68BA, 0x68BB, 0x68BC, 0x68C3, 0x68C4,
+	0x68C5, 0x68C6, 0x68C8, 0x68C9, 0x68CA, 0x68CB, 0x68CC, 0x68CD,
+	0x68CF, 0x68D0, 0x68D2, 0x68D4, 0x68D5, 0x68D6, 0x68D7, 0x68D8,
+	0x68D9, 0x68DA, 0x68DF, 0x68E0, 0x68E1, 0x68E3, 0x68E4, 0x68E5,
+	0x68E7, 0x68E8, 0x68EC, 0x68ED, 0x68EE, 0x68EF, 0x68F0, 0x68F1,
+	0x68F2, 0x68F7, 0x68F9, 0x68FA, 0x68FB, 0x68FC, 0x6900, 0x6901,
+	0x6903, 0x6904, 0x6905, 0x6907, 0x6908, 0x690A, 0x690B, 0x690C,
+	0x690D, 0x690E, 0x690F, 0x6912, 0x6919, 0x691A, 0x691B, 0x691C,
+	0x6921, 0x6922, 0x6923, 0x6925, 0x6926, 0x6928, 0x692A, 0x6930,
+	0x6934, 0x6935, 0x6936, 0x6939, 0x693B, 0x693D, 0x693F, 0x6942,
+	0x6946, 0x6949, 0x694A, 0x6953, 0x6954, 0x6955, 0x6957, 0x6959,
+	0x695A, 0x695C, 0x695D, 0x695E, 0x6960, 0x6961, 0x6962, 0x6963,
+	0x6964, 0x6968, 0x6969, 0x696A, 0x696B, 0x696C, 0x696D, 0x696E,
+	0x696F, 0x6972, 0x6973, 0x6974, 0x6975, 0x6977, 0x6978, 0x6979,
+	0x697A, 0x697C, 0x697D, 0x697E, 0x697F, 0x6980, 0x6981, 0x6982,
+	0x698A, 0x698E, 0x6991, 0x6992, 0x6994, 0x6995, 0x6996, 0x6998,
+	0x699B, 0x699C, 0x69A0, 0x69A5, 0x69A6, 0x69A7, 0x69AD, 0x69AE,
+	0x69B0, 0x69B1, 0x69B2, 0x69B4, 0x69B7, 0x69BA, 0x69BB, 0x69BC,
+	0x69BE, 0x69BF, 0x69C0, 0x69C1, 0x69C3, 0x69C7, 0x69CA, 0x69CB,
+	0x69CC, 0x69CD, 0x69CE, 0x69CF, 0x69D0, 0x69D1, 0x69D3, 0x69D6,
+	0x69D8, 0x69D9, 0x69DD, 0x69DE, 0x69E2, 0x69E3, 0x69E7, 0x69E8,
+	0x69E9, 0x69EA, 0x69EB, 0x69ED, 0x69EE, 0x69EF, 0x69F2, 0x69F3,
+	0x69F4, 0x69F5, 0x69F6, 0x69F9, 0x69FB, 0x69FD, 0x69FE, 0x69FF,
+	0x6A02, 0x6A05, 0x6A0A, 0x6A0B, 0x6A0C, 0x6A0F, 0x6A11, 0x6A12,
+	0x6A13, 0x6A14, 0x6A15, 0x6A17, 0x6A19, 0x6A1A, 0x6A1B, 0x6A1D,
+	0x6A1E, 0x6A1F, 0x6A21, 0x6A22, 0x6A23, 0x6A29, 0x6A2A, 0x6A2B,
+	0x6A2E, 0x6A30, 0x6A32, 0x6A33, 0x6A34, 0x6A35, 0x6A36, 0x6A38,
+	0x6A39, 0x6A3A, 0x6A3B, 0x6A3D, 0x6A3E, 0x6A3F, 0x6A44, 0x6A45,
+	0x6A46, 0x6A47, 0x6A48, 0x6A49, 0x6A4B, 0x6A4E, 0x6A50, 0x6A52,
+	0x6A56, 0x6A58, 0x6A59, 0x6A5B, 0x6A5F, 0x6A61, 0x6A62, 0x6A64,
+	0x6A66, 0x6A6B, 0x6A72, 0x6A73, 0x6A78, 0x6A7A, 0x6A7E, 0x6A7F,
+	0x6A80, 0x6A83, 0x6A84, 0x6A89, 0x6A8B, 0x6A8D, 0x6A8E, 0x6A90,
+	0x6A91, 0x6A94, 0x6A97, 0x6A9C, 0x6A9D, 0x6A9E, 0x6A9F, 0x6AA0,
+	0x6AA1, 0x6AA2, 0x6AA3, 0x6AA5, 0x6AAA, 0x6AAB, 0x6AAC, 0x6AAE,
+	0x6AB3, 0x6AB8, 0x6ABB, 0x6ABD, 0x6AC1, 0x6AC2, 0x6AC3, 0x6AC6,
+	0x6AD0, 0x6AD1, 0x6AD3, 0x6AD4, 0x6ADA, 0x6ADB, 0x6ADC, 0x6ADD,
+	0x6ADE, 0x6ADF, 0x6AE4, 0x6AE7, 0x6AE8, 0x6AEA, 0x6AEC, 0x6AF1,
+	0x6AF2, 0x6AF3, 0x6AFA, 0x6AFB, 0x6AFD, 0x6B04, 0x6B05, 0x6B0A,
+	0x6B0B, 0x6B0F, 0x6B10, 0x6B11, 0x6B12, 0x6B16, 0x6B17, 0x6B1B,
+	0x6B1D, 0x6B1E, 0x6B1F, 0x6B20, 0x6B21, 0x6B23, 0x6B27, 0x6B2C,
+	0x6B2F, 0x6B32, 0x6B35, 0x6B37, 0x6B38, 0x6B39, 0x6B3A, 0x6B3D,
+	0x6B3E, 0x6B43, 0x6B46, 0x6B47, 0x6B49, 0x6B4A, 0x6B4C, 0x6B4E,
+	0x6B50, 0x6B53, 0x6B54, 0x6B56, 0x6B58, 0x6B59, 0x6B5B, 0x6B5F,
+	0x6B60, 0x6B61, 0x6B62, 0x6B63, 0x6B64, 0x6B65, 0x6B66, 0x6B67,
+	0x6B69, 0x6B6A, 0x6B6C, 0x6B6F, 0x6B73, 0x6B74, 0x6B75, 0x6B77,
+	0x6B78, 0x6B79, 0x6B7A, 0x6B7B, 0x6B7F, 0x6B80, 0x6B81, 0x6B82,
+	0x6B83, 0x6B84, 0x6B86, 0x6B89, 0x6B8A, 0x6B8B, 0x6B8D, 0x6B95,
+	0x6B96, 0x6B98, 0x6B9B, 0x6B9E, 0x6BA4, 0x6BA9, 0x6BAA, 0x6BAB,
+	0x6BAD, 0x6BAE, 0x6BAF, 0x6BB1, 0x6BB2, 0x6BB3, 0x6BB4, 0x6BB5,
+	0x6BB7, 0x6BBA, 0x6BBB, 0x6BBC, 0x6BBD, 0x6BBE, 0x6BBF, 0x6BC0,
+	0x6BC5, 0x6BC6, 0x6BC7, 0x6BC8, 0x6BC9, 0x6BCB, 0x6BCD, 0x6BCE,
+	0x6BCF, 0x6BD2, 0x6BD3, 0x6BD4, 0x6BD6, 0x6BD7, 0x6BD8, 0x6BDA,
+	0x6BDB, 0x6BDF, 0x6BE6, 0x6BE7, 0x6BEB, 0x6BEC, 0x6BEE, 0x6BEF,
+	0x6BF1, 0x6BF3, 0x6BFF, 0x6C02, 0x6C05, 0x6C08, 0x6C0A, 0x6C0E,
+	0x6C0F, 0x6C10, 0x6C11, 0x6C13, 0x6C14, 0x6C17, 0x6C1B, 0x6C23,
+	0x6C24, 0x6C33, 0x6C34, 0x6C35, 0x6C36, 0x6C37, 0x6C38, 0x6C3A,
+	0x6C3E, 0x6C3F, 0x6C40, 0x6C41, 0x6C42, 0x6C4D, 0x6C4E, 0x6C50,
+	0x6C55, 0x6C57, 0x6C59, 0x6C5A, 0x6C5B, 0x6C5C, 0x6C5D, 0x6C5E,
+	0x6C5F, 0x6C60, 0x6C62, 0x6C67, 0x6C68, 0x6C6A, 0x6C6D, 0x6C70,
+	0x6C72, 0x6C73, 0x6C74, 0x6C76, 0x6C7A, 0x6C7D, 0x6C7E, 0x6C81,
+	0x6C82, 0x6C83, 0x6C84, 0x6C85, 0x6C86, 0x6C88, 0x6C89, 0x6C8C,
+	0x6C8D, 0x6C90, 0x6C92, 0x6C93, 0x6C94, 0x6C95, 0x6C96, 0x6C97,
+	0x6C98, 0x6C99, 0x6C9A, 0x6C9B, 0x6C9C, 0x6CA1, 0x6CA2, 0x6CAA,
+	0x6CAB, 0x6CAD, 0x6CAE, 0x6CB1, 0x6CB3, 0x6CB8, 0x6CB9, 0x6CBA,
+	0x6CBB, 0x6CBC, 0x6CBD, 0x6CBE, 0x6CBF, 0x6CC1, 0x6CC2, 0x6CC4,
+	0x6CC5, 0x6CC6, 0x6CC9, 0x6CCA, 0x6CCC, 0x6CD0, 0x6CD3, 0x6CD4,
+	0x6CD5, 0x6CD6, 0x6CD7, 0x6CD9, 0x6CDA, 0x6CDB, 0x6CDC, 0x6CDD,
+	0x6CE0, 0x6CE1, 0x6CE2, 0x6CE3, 0x6CE5, 0x6CE8, 0x6CE9, 0x6CEA,
+	0x6CEB, 0x6CEC, 0x6CED, 0x6CEE, 0x6CEF, 0x6CF0, 0x6CF1, 0x6CF3,
+	0x6CFB, 0x6D00, 0x6D04, 0x6D0A, 0x6D0B, 0x6D0C, 0x6D0E, 0x6D12,
+	0x6D17, 0x6D19, 0x6D1B, 0x6D1E, 0x6D1F, 0x6D24, 0x6D25, 0x6D26,
+	0x6D27, 0x6D29, 0x6D2A, 0x6D2B, 0x6D2E, 0x6D2F, 0x6D31, 0x6D32,
+	0x6D33, 0x6D34, 0x6D35, 0x6D36, 0x6D38, 0x6D39, 0x6D3B, 0x6D3C,
+	0x6D3D, 0x6D3E, 0x6D3F, 0x6D41, 0x6D44, 0x6D45, 0x6D58, 0x6D59,
+	0x6D5A, 0x6D5B, 0x6D5C, 0x6D5E, 0x6D60, 0x6D63, 0x6D64, 0x6D65,
+	0x6D66, 0x6D69, 0x6D6A, 0x6D6C, 0x6D6E, 0x6D70, 0x6D74, 0x6D77,
+	0x6D78, 0x6D79, 0x6D80, 0x6D81, 0x6D82, 0x6D85, 0x6D87, 0x6D88,
+	0x6D89, 0x6D8A, 0x6D8C, 0x6D8D, 0x6D8E, 0x6D91, 0x6D93, 0x6D94,
+	0x6D95, 0x6D98, 0x6D99, 0x6D9B, 0x6D9C, 0x6DAA, 0x6DAB, 0x6DAC,
+	0x6DAE, 0x6DAF, 0x6DB2, 0x6DB4, 0x6DB5, 0x6DB8, 0x6DBC, 0x6DBF,
+	0x6DC0, 0x6DC2, 0x6DC4, 0x6DC5, 0x6DC6, 0x6DC7, 0x6DC8, 0x6DCB,
+	0x6DCC, 0x6DCE, 0x6DCF, 0x6DD0, 0x6DD1, 0x6DD2, 0x6DD5, 0x6DD6,
+	0x6DD8, 0x6DD9, 0x6DDA, 0x6DDB, 0x6DDD, 0x6DDE, 0x6DDF, 0x6DE1,
+	0x6DE4, 0x6DE6, 0x6DE8, 0x6DE9, 0x6DEA, 0x6DEB, 0x6DEC, 0x6DEE,
+	0x6DF1, 0x6DF3, 0x6DF5, 0x6DF6, 0x6DF7, 0x6DF9, 0x6DFA, 0x6DFB,
+	0x6DFC, 0x6E05, 0x6E07, 0x6E08, 0x6E09, 0x6E0A, 0x6E0B, 0x6E13,
+	0x6E15, 0x6E17, 0x6E19, 0x6E1A, 0x6E1B, 0x6E1D, 0x6E1E, 0x6E1F,
+	0x6E20, 0x6E21, 0x6E22, 0x6E23, 0x6E24, 0x6E25, 0x6E26, 0x6E27,
+	0x6E29, 0x6E2B, 0x6E2C, 0x6E2D, 0x6E2E, 0x6E2F, 0x6E32, 0x6E34,
+	0x6E36, 0x6E38, 0x6E3A, 0x6E3C, 0x6E3E, 0x6E43, 0x6E44, 0x6E48,
+	0x6E49, 0x6E4A, 0x6E4B, 0x6E4C, 0x6E4D, 0x6E4E, 0x6E4F, 0x6E51,
+	0x6E53, 0x6E54, 0x6E56, 0x6E57, 0x6E58, 0x6E5B, 0x6E5C, 0x6E5E,
+	0x6E5F, 0x6E63, 0x6E67, 0x6E6B, 0x6E6E, 0x6E6F, 0x6E72, 0x6E76,
+	0x6E7E, 0x6E7F, 0x6E80, 0x6E82, 0x6E8C, 0x6E8F, 0x6E90, 0x6E93,
+	0x6E96, 0x6E98, 0x6E9C, 0x6E9D, 0x6E9F, 0x6EA2, 0x6EA5, 0x6EA7,
+	0x6EAA, 0x6EAB, 0x6EAF, 0x6EB1, 0x6EB2, 0x6EB4, 0x6EB6, 0x6EB7,
+	0x6EBA, 0x6EBD, 0x6EBF, 0x6EC1, 0x6EC2, 0x6EC3, 0x6EC4, 0x6EC5,
+	0x6EC7, 0x6EC9, 0x6ECA, 0x6ECB, 0x6ECC, 0x6ECE, 0x6ED1, 0x6ED3,
+	0x6ED4, 0x6ED5, 0x6ED9, 0x6EDD, 0x6EDE, 0x6EEB, 0x6EEC, 0x6EEF,
+	0x6EF2, 0x6EF4, 0x6EF7, 0x6EF8, 0x6EF9, 0x6EFB, 0x6EFE, 0x6EFF,
+	0x6F01, 0x6F02, 0x6F06, 0x6F09, 0x6F0A, 0x6F0C, 0x6F0F, 0x6F10,
+	0x6F11, 0x6F13, 0x6F14, 0x6F15, 0x6F18, 0x6F1A, 0x6F20, 0x6F22,
+	0x6F23, 0x6F25, 0x6F2A, 0x6F2B, 0x6F2C, 0x6F2F, 0x6F31, 0x6F32,
+	0x6F33, 0x6F35, 0x6F36, 0x6F38, 0x6F3C, 0x6F3E, 0x6F3F, 0x6F41,
+	0x6F45, 0x6F51, 0x6F52, 0x6F54, 0x6F57, 0x6F58, 0x6F59, 0x6F5A,
+	0x6F5B, 0x6F5C, 0x6F5E, 0x6F5F, 0x6F60, 0x6F61, 0x6F62, 0x6F64,
+	0x6F66, 0x6F68, 0x6F6D, 0x6F6E, 0x6F6F, 0x6F70, 0x6F74, 0x6F78,
+	0x6F7A, 0x6F7C, 0x6F7D, 0x6F7E, 0x6F80, 0x6F81, 0x6F82, 0x6F84,
+	0x6F86, 0x6F88, 0x6F8C, 0x6F8D, 0x6F8E, 0x6F90, 0x6F91, 0x6F94,
+	0x6F96, 0x6F97, 0x6F98, 0x6F9F, 0x6FA0, 0x6FA1, 0x6FA3, 0x6FA4,
+	0x6FA5, 0x6FA7, 0x6FAA, 0x6FAF, 0x6FB1, 0x6FB3, 0x6FB5, 0x6FB6,
+	0x6FB9, 0x6FBC, 0x6FBE, 0x6FC0, 0x6FC1, 0x6FC2, 0x6FC3, 0x6FC6,
+	0x6FC7, 0x6FC8, 0x6FC9, 0x6FCA, 0x6FD4, 0x6FD5, 0x6FD8, 0x6FDA,
+	0x6FDB, 0x6FDE, 0x6FDF, 0x6FE0, 0x6FE1, 0x6FE4, 0x6FE9, 0x6FEB,
+	0x6FEC, 0x6FEE, 0x6FEF, 0x6FF0, 0x6FF1, 0x6FF3, 0x6FF5, 0x6FF6,
+	0x6FF9, 0x6FFA, 0x6FFC, 0x6FFE, 0x7000, 0x7001, 0x7005, 0x7006,
+	0x7007, 0x7009, 0x700A, 0x700B, 0x700F, 0x7011, 0x7015, 0x7018,
+	0x701A, 0x701B, 0x701D, 0x701E, 0x701F, 0x7023, 0x7026, 0x7027,
+	0x7028, 0x702C, 0x7030, 0x7032, 0x7039, 0x703A, 0x703C, 0x703E,
+	0x7043, 0x7047, 0x704A, 0x704B, 0x704C, 0x704E, 0x7051, 0x7054,
+	0x7058, 0x705D, 0x705E, 0x7063, 0x7064, 0x7065, 0x7069, 0x706B,
+	0x706C, 0x706E, 0x706F, 0x7070, 0x7075, 0x7076, 0x7078, 0x707C,
+	0x707D, 0x707E, 0x7081, 0x7085, 0x7086, 0x7089, 0x708A, 0x708E,
+	0x7092, 0x7095, 0x7097, 0x7099, 0x709F, 0x70A4, 0x70AB, 0x70AC,
+	0x70AD, 0x70AE, 0x70AF, 0x70B1, 0x70B3, 0x70B7, 0x70B8, 0x70B9,
+	0x70BA, 0x70BB, 0x70C8, 0x70CA, 0x70CB, 0x70CF, 0x70D1, 0x70D3,
+	0x70D4, 0x70D8, 0x70D9, 0x70DC, 0x70DD, 0x70DF, 0x70E4, 0x70EC,
+	0x70F1, 0x70F9, 0x70FD, 0x7103, 0x7104, 0x7106, 0x7107, 0x7108,
+	0x7109, 0x710C, 0x710F, 0x7114, 0x7119, 0x711A, 0x711C, 0x711E,
+	0x7120, 0x7121, 0x7126, 0x712B, 0x712E, 0x712F, 0x7130, 0x7131,
+	0x7136, 0x713C, 0x7146, 0x7147, 0x7149, 0x714A, 0x714C, 0x714E,
+	0x7150, 0x7151, 0x7152, 0x7153, 0x7155, 0x7156, 0x7159, 0x715C,
+	0x715E, 0x7160, 0x7162, 0x7164, 0x7165, 0x7166, 0x7167, 0x7168,
+	0x7169, 0x716C, 0x716E, 0x717D, 0x7180, 0x7184, 0x7185, 0x7187,
+	0x7188, 0x718A, 0x718F, 0x7192, 0x7194, 0x7195, 0x7196, 0x7199,
+	0x719B, 0x719F, 0x71A0, 0x71A2, 0x71A8, 0x71AC, 0x71AE, 0x71AF,
+	0x71B1, 0x71B3, 0x71B9, 0x71BA, 0x71BE, 0x71C1, 0x71C3, 0x71C4,
+	0x71C8, 0x71C9, 0x71CB, 0x71CE, 0x71D0, 0x71D2, 0x71D3, 0x71D4,
+	0x71D5, 0x71D7, 0x71D9, 0x71DC, 0x71DF, 0x71E0, 0x71E5, 0x71E6,
+	0x71E7, 0x71EC, 0x71ED, 0x71EE, 0x71F5, 0x71F9, 0x71FB, 0x71FC,
+	0x71FE, 0x71FF, 0x7200, 0x7206, 0x7207, 0x720D, 0x7210, 0x7215,
+	0x721B, 0x7228, 0x722A, 0x722B, 0x722C, 0x722D, 0x7230, 0x7232,
+	0x7234, 0x7235, 0x7236, 0x7238, 0x7239, 0x723A, 0x723B, 0x723C,
+	0x723D, 0x723E, 0x723F, 0x7240, 0x7242, 0x7246, 0x7247, 0x7248,
+	0x724B, 0x724C, 0x7252, 0x7253, 0x7255, 0x7256, 0x7257, 0x7258,
+	0x7259, 0x725B, 0x725D, 0x725F, 0x7261, 0x7262, 0x7263, 0x7267,
+	0x7269, 0x726E, 0x726F, 0x7272, 0x7274, 0x7278, 0x7279, 0x727D,
+	0x727E, 0x727F, 0x7280, 0x7281, 0x7282, 0x7287, 0x728D, 0x728E,
+	0x7292, 0x7296, 0x729B, 0x72A0, 0x72A2, 0x72A7, 0x72AC, 0x72AD,
+	0x72AE, 0x72AF, 0x72B0, 0x72B1, 0x72B2, 0x72B6, 0x72B9, 0x72BE,
+	0x72C0, 0x72C1, 0x72C2, 0x72C3, 0x72C4, 0x72C6, 0x72CC, 0x72CE,
+	0x72D0, 0x72D2, 0x72D7, 0x72D9, 0x72DB, 0x72E0, 0x72E1, 0x72E2,
+	0x72E9, 0x72EC, 0x72ED, 0x72F3, 0x72F7, 0x72F8, 0x72F9, 0x72FA,
+	0x72FB, 0x72FC, 0x72FD, 0x7307, 0x730A, 0x7312, 0x7316, 0x7317,
+	0x7318, 0x7319, 0x731B, 0x731C, 0x731D, 0x731F, 0x7325, 0x7327,
+	0x7328, 0x7329, 0x732A, 0x732B, 0x732C, 0x732E, 0x732F, 0x7331,
+	0x7333, 0x7334, 0x7336, 0x7337, 0x7339, 0x733D, 0x733E, 0x733F,
+	0x7344, 0x7345, 0x734E, 0x734F, 0x7350, 0x7352, 0x7357, 0x7363,
+	0x7366, 0x7368, 0x736A, 0x736B, 0x736C, 0x736E, 0x736F, 0x7370,
+	0x7371, 0x7372, 0x7375, 0x7377, 0x7378, 0x737A, 0x737B, 0x737C,
+	0x7381, 0x7384, 0x7385, 0x7387, 0x7389, 0x738A, 0x738B, 0x7394,
+	0x7395, 0x7396, 0x7398, 0x739C, 0x739E, 0x739F, 0x73A0, 0x73A2,
+	0x73A5, 0x73A6, 0x73A8, 0x73A9, 0x73AB, 0x73B2, 0x73B3, 0x73B5,
+	0x73B7, 0x73B9, 0x73BB, 0x73BC, 0x73BF, 0x73C0, 0x73C2, 0x73C5,
+	0x73C8, 0x73C9, 0x73CA, 0x73CB, 0x73CD, 0x73CE, 0x73CF, 0x73D6,
+	0x73D9, 0x73DE, 0x73E0, 0x73E1, 0x73E3, 0x73E5, 0x73E7, 0x73E9,
+	0x73EA, 0x73ED, 0x73EE, 0x73F1, 0x73F8, 0x73F9, 0x73FA, 0x73FE,
+	0x7401, 0x7403, 0x7405, 0x7406, 0x7407, 0x7409, 0x740A, 0x7413,
+	0x741A, 0x741B, 0x7422, 0x7424, 0x7425, 0x7426, 0x7428, 0x742A,
+	0x742B, 0x742C, 0x742E, 0x742F, 0x7430, 0x7431, 0x7432, 0x7433,
+	0x7434, 0x7435, 0x7436, 0x7439, 0x743A, 0x743F, 0x7440, 0x7441,
+	0x7443, 0x7444, 0x7446, 0x7447, 0x744B, 0x744D, 0x7452, 0x7453,
+	0x7455, 0x7457, 0x7459, 0x745A, 0x745B, 0x745C, 0x745D, 0x745E,
+	0x745F, 0x7460, 0x7462, 0x7463, 0x7464, 0x7469, 0x746A, 0x746B,
+	0x746D, 0x746F, 0x7470, 0x7471, 0x7473, 0x7476, 0x747E, 0x7481,
+	0x7483, 0x7485, 0x7486, 0x7487, 0x7488, 0x7489, 0x748B, 0x7490,
+	0x7492, 0x7497, 0x7498, 0x7499, 0x749C, 0x749E, 0x749F, 0x74A0,
+	0x74A1, 0x74A2, 0x74A3, 0x74A5, 0x74A6, 0x74A7, 0x74A8, 0x74A9,
+	0x74AA, 0x74AB, 0x74B0, 0x74B5, 0x74B9, 0x74BA, 0x74BB, 0x74BD,
+	0x74BF, 0x74C8, 0x74C9, 0x74CA, 0x74CF, 0x74D4, 0x74D6, 0x74D8,
+	0x74DA, 0x74DC, 0x74DE, 0x74E0, 0x74E2, 0x74E3, 0x74E6, 0x74E7,
+	0x74E9, 0x74EB, 0x74EE, 0x74EF, 0x74F0, 0x74F1, 0x74F2, 0x74F6,
+	0x74F7, 0x74F8, 0x74FA, 0x74FF, 0x7501, 0x7503, 0x7504, 0x7505,
+	0x750C, 0x750D, 0x750E, 0x7511, 0x7513, 0x7515, 0x7517, 0x7518,
+	0x751A, 0x751C, 0x751E, 0x751F, 0x7520, 0x7523, 0x7524, 0x7525,
+	0x7526, 0x7528, 0x752A, 0x752B, 0x752C, 0x752F, 0x7530, 0x7531,
+	0x7532, 0x7533, 0x7537, 0x7538, 0x753A, 0x753B, 0x753C, 0x753D,
+	0x753E, 0x7540, 0x7544, 0x7546, 0x7548, 0x7549, 0x754A, 0x754B,
+	0x754C, 0x754D, 0x754E, 0x754F, 0x7550, 0x7551, 0x7552, 0x7554,
+	0x7559, 0x755A, 0x755B, 0x755C, 0x755D, 0x7560, 0x7562, 0x7564,
+	0x7565, 0x7566, 0x7567, 0x7569, 0x756A, 0x756B, 0x756C, 0x756D,
+	0x756F, 0x7570, 0x7571, 0x7572, 0x7573, 0x7574, 0x7576, 0x7577,
+	0x7578, 0x7579, 0x757A, 0x757D, 0x757E, 0x757F, 0x7581, 0x7582,
+	0x7586, 0x7587, 0x7589, 0x758A, 0x758B, 0x758C, 0x758E, 0x758F,
+	0x7591, 0x7592, 0x7594, 0x759A, 0x759D, 0x75A2, 0x75A3, 0x75A5,
+	0x75AB, 0x75B0, 0x75B1, 0x75B2, 0x75B3, 0x75B5, 0x75B7, 0x75B8,
+	0x75B9, 0x75BC, 0x75BD, 0x75BE, 0x75BF, 0x75C0, 0x75C2, 0x75C3,
+	0x75C5, 0x75C6, 0x75C7, 0x75CA, 0x75CD, 0x75CE, 0x75CF, 0x75D2,
+	0x75D3, 0x75D4, 0x75D5, 0x75D8, 0x75D9, 0x75DB, 0x75DD, 0x75DE,
+	0x75DF, 0x75E0, 0x75E2, 0x75E3, 0x75E4, 0x75E7, 0x75E9, 0x75EC,
+	0x75EE, 0x75F0, 0x75F1, 0x75F2, 0x75F3, 0x75F4, 0x75F9, 0x75FA,
+	0x75FC, 0x75FE, 0x75FF, 0x7600, 0x7601, 0x7602, 0x7603, 0x7607,
+	0x7608, 0x7609, 0x760B, 0x760D, 0x760F, 0x7613, 0x7615, 0x7616,
+	0x7618, 0x7619, 0x761B, 0x761C, 0x761E, 0x761F, 0x7620, 0x7621,
+	0x7622, 0x7624, 0x7625, 0x7626, 0x7627, 0x7628, 0x762D, 0x7630,
+	0x7633, 0x7634, 0x7635, 0x763B, 0x763C, 0x7641, 0x7642, 0x7643,
+	0x7646, 0x7647, 0x7648, 0x7649, 0x764B, 0x764C, 0x7652, 0x7655,
+	0x7656, 0x7658, 0x765C, 0x7661, 0x7662, 0x7664, 0x7665, 0x7667,
+	0x7668, 0x7669, 0x766A, 0x766C, 0x766D, 0x766E, 0x766F, 0x7670,
+	0x7671, 0x7672, 0x7676, 0x7678, 0x767A, 0x767B, 0x767C, 0x767D,
+	0x767E, 0x7680, 0x7681, 0x7683, 0x7684, 0x7686, 0x7687, 0x7688,
+	0x768B, 0x768E, 0x7690, 0x7693, 0x7695, 0x7696, 0x7699, 0x769A,
+	0x769B, 0x769C, 0x769D, 0x769E, 0x76A0, 0x76A1, 0x76A6, 0x76A7,
+	0x76A8, 0x76AA, 0x76AE, 0x76AF, 0x76B0, 0x76B4, 0x76B6, 0x76B7,
+	0x76B8, 0x76B9, 0x76BA, 0x76BF, 0x76C2, 0x76C3, 0x76C5, 0x76C6,
+	0x76C8, 0x76C9, 0x76CA, 0x76CC, 0x76CD, 0x76CE, 0x76D2, 0x76D4,
+	0x76D6, 0x76D7, 0x76DB, 0x76DC, 0x76DE, 0x76DF, 0x76E1, 0x76E3,
+	0x76E4, 0x76E5, 0x76E6, 0x76E7, 0x76E8, 0x76EA, 0x76EC, 0x76EE,
+	0x76F1, 0x76F2, 0x76F4, 0x76F8, 0x76FB, 0x76FC, 0x76FE, 0x7701,
+	0x7704, 0x7707, 0x7708, 0x7709, 0x770A, 0x770B, 0x770C, 0x7717,
+	0x7719, 0x771A, 0x771B, 0x771E, 0x771F, 0x7720, 0x7724, 0x7725,
+	0x7726, 0x7729, 0x772D, 0x7734, 0x7735, 0x7736, 0x7737, 0x7738,
+	0x773A, 0x773C, 0x7740, 0x7746, 0x7747, 0x774D, 0x774E, 0x7758,
+	0x775A, 0x775B, 0x775C, 0x775F, 0x7760, 0x7761, 0x7762, 0x7763,
+	0x7765, 0x7766, 0x7768, 0x776A, 0x776B, 0x7772, 0x7779, 0x777A,
+	0x777C, 0x777D, 0x777E, 0x777F, 0x7780, 0x778B, 0x778E, 0x7791,
+	0x7794, 0x779A, 0x779E, 0x779F, 0x77A0, 0x77A2, 0x77A4, 0x77A5,
+	0x77A9, 0x77AA, 0x77AC, 0x77AD, 0x77B0, 0x77B3, 0x77B6, 0x77B9,
+	0x77BB, 0x77BC, 0x77BD, 0x77BF, 0x77C7, 0x77CD, 0x77D7, 0x77DA,
+	0x77DB, 0x77DC, 0x77DE, 0x77DF, 0x77E0, 0x77E2, 0x77E3, 0x77E4,
+	0x77E5, 0x77E6, 0x77E7, 0x77E9, 0x77EA, 0x77EC, 0x77ED, 0x77EE,
+	0x77EF, 0x77F0, 0x77F3, 0x77F4, 0x77FB, 0x77FC, 0x7802, 0x7805,
+	0x7806, 0x7809, 0x780C, 0x780D, 0x7812, 0x7814, 0x7815, 0x7819,
+	0x7820, 0x7821, 0x7825, 0x7826, 0x7827, 0x782C, 0x782D, 0x7832,
+	0x7834, 0x783A, 0x783F, 0x7843, 0x7845, 0x7847, 0x784E, 0x784F,
+	0x7851, 0x785D, 0x7864, 0x7868, 0x786A, 0x786B, 0x786C, 0x786E,
+	0x786F, 0x7872, 0x7874, 0x787C, 0x7881, 0x7886, 0x7887, 0x788A,
+	0x788C, 0x788D, 0x788E, 0x7891, 0x7893, 0x7894, 0x7895, 0x7897,
+	0x789A, 0x789D, 0x789E, 0x789F, 0x78A3, 0x78A4, 0x78A7, 0x78A9,
+	0x78AA, 0x78AD, 0x78AF, 0x78B0, 0x78B5, 0x78BA, 0x78BB, 0x78BC,
+	0x78BE, 0x78C1, 0x78C5, 0x78C6, 0x78C8, 0x78CA, 0x78CB, 0x78CC,
+	0x78CE, 0x78D0, 0x78D1, 0x78D4, 0x78D5, 0x78DA, 0x78E0, 0x78E1,
+	0x78E4, 0x78E6, 0x78E7, 0x78E8, 0x78EC, 0x78EF, 0x78F2, 0x78F4,
+	0x78F7, 0x78F9, 0x78FA, 0x78FB, 0x78FD, 0x78FE, 0x7900, 0x7901,
+	0x7907, 0x790E, 0x7910, 0x7911, 0x7912, 0x7919, 0x791B, 0x791C,
+	0x7925, 0x7926, 0x792A, 0x792B, 0x792C, 0x792E, 0x7930, 0x7931,
+	0x7934, 0x793A, 0x793B, 0x793C, 0x793E, 0x7940, 0x7941, 0x7945,
+	0x7946, 0x7947, 0x7948, 0x7949, 0x794A, 0x7950, 0x7953, 0x7955,
+	0x7956, 0x7957, 0x7958, 0x795A, 0x795B, 0x795C, 0x795D, 0x795E,
+	0x795F, 0x7960, 0x7962, 0x7965, 0x7967, 0x7968, 0x796D, 0x7972,
+	0x7977, 0x7979, 0x797A, 0x797F, 0x7980, 0x7981, 0x7984, 0x7985,
+	0x798A, 0x798D, 0x798E, 0x798F, 0x7994, 0x7995, 0x7996, 0x7998,
+	0x799B, 0x799D, 0x79A1, 0x79A6, 0x79A7, 0x79A9, 0x79AA, 0x79AE,
+	0x79B0, 0x79B1, 0x79B3, 0x79B4, 0x79B8, 0x79B9, 0x79BA, 0x79BB,
+	0x79BD, 0x79BE, 0x79BF, 0x79C0, 0x79C1, 0x79C2, 0x79C7, 0x79C8,
+	0x79C9, 0x79CA, 0x79CB, 0x79CC, 0x79CD, 0x79D1, 0x79D2, 0x79D4,
+	0x79D5, 0x79D6, 0x79D8, 0x79DE, 0x79DF, 0x79E1, 0x79E3, 0x79E4,
+	0x79E6, 0x79E7, 0x79E9, 0x79EB, 0x79EC, 0x79ED, 0x79F0, 0x79FB,
+	0x7A00, 0x7A03, 0x7A08, 0x7A0A, 0x7A0B, 0x7A0D, 0x7A0E, 0x7A11,
+	0x7A14, 0x7A15, 0x7A17, 0x7A18, 0x7A19, 0x7A1A, 0x7A1B, 0x7A1C,
+	0x7A1E, 0x7A1F, 0x7A20, 0x7A2D, 0x7A2E, 0x7A31, 0x7A32, 0x7A37,
+	0x7A38, 0x7A39, 0x7A3B, 0x7A3C, 0x7A3D, 0x7A3E, 0x7A3F, 0x7A40,
+	0x7A42, 0x7A43, 0x7A46, 0x7A47, 0x7A49, 0x7A4C, 0x7A4D, 0x7A4E,
+	0x7A4F, 0x7A50, 0x7A56, 0x7A57, 0x7A59, 0x7A5C, 0x7A5D, 0x7A5F,
+	0x7A60, 0x7A61, 0x7A62, 0x7A63, 0x7A67, 0x7A69, 0x7A6A, 0x7A6B,
+	0x7A6D, 0x7A70, 0x7A74, 0x7A75, 0x7A76, 0x7A78, 0x7A79, 0x7A7A,
+	0x7A7D, 0x7A7F, 0x7A81, 0x7A82, 0x7A83, 0x7A84, 0x7A85, 0x7A88,
+	0x7A8A, 0x7A90, 0x7A92, 0x7A93, 0x7A95, 0x7A96, 0x7A97, 0x7A98,
+	0x7A9F, 0x7AA0, 0x7AA3, 0x7AA9, 0x7AAA, 0x7AAC, 0x7AAE, 0x7AAF,
+	0x7AB0, 0x7AB3, 0x7AB6, 0x7AB9, 0x7ABA, 0x7ABB, 0x7ABC, 0x7ABE,
+	0x7ABF, 0x7AC3, 0x7AC4, 0x7AC5, 0x7AC7, 0x7AC8, 0x7ACA, 0x7ACB,
+	0x7ACC, 0x7ACD, 0x7ACE, 0x7ACF, 0x7AD1, 0x7AD2, 0x7AD3, 0x7AD5,
+	0x7AD9, 0x7ADA, 0x7ADC, 0x7ADD, 0x7ADF, 0x7AE0, 0x7AE1, 0x7AE2,
+	0x7AE3, 0x7AE5, 0x7AE6, 0x7AE7, 0x7AE8, 0x7AEA, 0x7AEB, 0x7AED,
+	0x7AEF, 0x7AF0, 0x7AF4, 0x7AF6, 0x7AF8, 0x7AF9, 0x7AFA, 0x7AFD,
+	0x7AFF, 0x7B02, 0x7B04, 0x7B06, 0x7B07, 0x7B08, 0x7B0A, 0x7B0B,
+	0x7B0F, 0x7B11, 0x7B12, 0x7B18, 0x7B19, 0x7B1B, 0x7B1E, 0x7B20,
+	0x7B25, 0x7B26, 0x7B27, 0x7B28, 0x7B2A, 0x7B2C, 0x7B2D, 0x7B2E,
+	0x7B2F, 0x7B31, 0x7B33, 0x7B35, 0x7B36, 0x7B39, 0x7B3B, 0x7B3D,
+	0x7B41, 0x7B45, 0x7B46, 0x7B47, 0x7B48, 0x7B49, 0x7B4B, 0x7B4C,
+	0x7B4D, 0x7B4E, 0x7B4F, 0x7B50, 0x7B51, 0x7B52, 0x7B54, 0x7B55,
+	0x7B56, 0x7B5D, 0x7B60, 0x7B64, 0x7B65, 0x7B66, 0x7B67, 0x7B69,
+	0x7B6C, 0x7B6D, 0x7B6E, 0x7B6F, 0x7B70, 0x7B71, 0x7B72, 0x7B73,
+	0x7B74, 0x7B75, 0x7B79, 0x7B7A, 0x7B7F, 0x7B86, 0x7B87, 0x7B8B,
+	0x7B8D, 0x7B8F, 0x7B90, 0x7B91, 0x7B92, 0x7B94, 0x7B95, 0x7B97,
+	0x7B98, 0x7B99, 0x7B9A, 0x7B9B, 0x7B9C, 0x7B9D, 0x7B9E, 0x7B9F,
+	0x7BA1, 0x7BAA, 0x7BAD, 0x7BAF, 0x7BB1, 0x7BB4, 0x7BB5, 0x7BB8,
+	0x7BBC, 0x7BC0, 0x7BC1, 0x7BC4, 0x7BC5, 0x7BC6, 0x7BC7, 0x7BC9,
+	0x7BCA, 0x7BCB, 0x7BCC, 0x7BCF, 0x7BD4, 0x7BD6, 0x7BD7, 0x7BD9,
+	0x7BDA, 0x7BDD, 0x7BE0, 0x7BE4, 0x7BE5, 0x7BE6, 0x7BE9, 0x7BEA,
+	0x7BED, 0x7BF0, 0x7BF3, 0x7BF6, 0x7BF7, 0x7C00, 0x7C01, 0x7C03,
+	0x7C07, 0x7C0B, 0x7C0D, 0x7C0E, 0x7C0F, 0x7C11, 0x7C12, 0x7C13,
+	0x7C14, 0x7C17, 0x7C1E, 0x7C1F, 0x7C20, 0x7C21, 0x7C23, 0x7C26,
+	0x7C27, 0x7C2A, 0x7C2B, 0x7C31, 0x7C33, 0x7C36, 0x7C37, 0x7C38,
+	0x7C3D, 0x7C3E, 0x7C3F, 0x7C40, 0x7C43, 0x7C45, 0x7C4A, 0x7C4C,
+	0x7C4D, 0x7C4F, 0x7C50, 0x7C51, 0x7C54, 0x7C56, 0x7C57, 0x7C58,
+	0x7C59, 0x7C5E, 0x7C5F, 0x7C60, 0x7C61, 0x7C64, 0x7C65, 0x7C69,
+	0x7C6C, 0x7C6D, 0x7C6E, 0x7C6F, 0x7C70, 0x7C73, 0x7C75, 0x7C79,
+	0x7C7E, 0x7C81, 0x7C82, 0x7C83, 0x7C89, 0x7C8B, 0x7C8D, 0x7C8F,
+	0x7C90, 0x7C92, 0x7C94, 0x7C95, 0x7C97, 0x7C98, 0x7C9B, 0x7C9F,
+	0x7CA0, 0x7CA1, 0x7CA2, 0x7CA4, 0x7CA5, 0x7CA6, 0x7CA7, 0x7CA8,
+	0x7CAB, 0x7CAD, 0x7CAE, 0x7CB1, 0x7CB2, 0x7CB3, 0x7CB6, 0x7CB7,
+	0x7CB9, 0x7CBC, 0x7CBD, 0x7CBE, 0x7CBF, 0x7CC0, 0x7CC2, 0x7CC4,
+	0x7CC5, 0x7CC8, 0x7CCA, 0x7CCD, 0x7CCE, 0x7CD2, 0x7CD5, 0x7CD6,
+	0x7CD7, 0x7CD8, 0x7CD9, 0x7CDC, 0x7CDD, 0x7CDE, 0x7CDF, 0x7CE0,
+	0x7CE2, 0x7CE6, 0x7CE7, 0x7CEB, 0x7CEF, 0x7CF2, 0x7CF4, 0x7CF5,
+	0x7CF6, 0x7CF8, 0x7CFA, 0x7CFB, 0x7CFE, 0x7D00, 0x7D02, 0x7D03,
+	0x7D04, 0x7D05, 0x7D06, 0x7D07, 0x7D08, 0x7D09, 0x7D0A, 0x7D0B,
+	0x7D0D, 0x7D10, 0x7D12, 0x7D13, 0x7D14, 0x7D15, 0x7D17, 0x7D18,
+	0x7D19, 0x7D1A, 0x7D1B, 0x7D1C, 0x7D1D, 0x7D1E, 0x7D20, 0x7D21,
+	0x7D22, 0x7D23, 0x7D2B, 0x7D2C, 0x7D2E, 0x7D2F, 0x7D30, 0x7D31,
+	0x7D32, 0x7D33, 0x7D35, 0x7D39, 0x7D3A, 0x7D3D, 0x7D3E, 0x7D3F,
+	0x7D40, 0x7D41, 0x7D42, 0x7D43, 0x7D44, 0x7D45, 0x7D46, 0x7D47,
+	0x7D48, 0x7D4B, 0x7D4C, 0x7D4E, 0x7D4F, 0x7D50, 0x7D53, 0x7D56,
+	0x7D59, 0x7D5A, 0x7D5B, 0x7D5C, 0x7D5E, 0x7D61, 0x7D62, 0x7D63,
+	0x7D66, 0x7D68, 0x7D6A, 0x7D6E, 0x7D70, 0x7D71, 0x7D72, 0x7D73,
+	0x7D75, 0x7D76, 0x7D79, 0x7D7A, 0x7D7D, 0x7D7F, 0x7D83, 0x7D86,
+	0x7D88, 0x7D89, 0x7D8B, 0x7D8C, 0x7D8F, 0x7D93, 0x7D97, 0x7D99,
+	0x7D9A, 0x7D9B, 0x7D9C, 0x7D9D, 0x7D9F, 0x7DA0, 0x7DA2, 0x7DA3,
+	0x7DA6, 0x7DA7, 0x7DAA, 0x7DAB, 0x7DAC, 0x7DAD, 0x7DAE, 0x7DAF,
+	0x7DB0, 0x7DB1, 0x7DB2, 0x7DB4, 0x7DB5, 0x7DB6, 0x7DB7, 0x7DB8,
+	0x7DBA, 0x7DBB, 0x7DBD, 0x7DBE, 0x7DBF, 0x7DC0, 0x7DC2, 0x7DC7,
+	0x7DCA, 0x7DCB, 0x7DCC, 0x7DCF, 0x7DD1, 0x7DD2, 0x7DD5, 0x7DD6,
+	0x7DD7, 0x7DD8, 0x7DD9, 0x7DDA, 0x7DDC, 0x7DDD, 0x7DDE, 0x7DE0,
+	0x7DE1, 0x7DE3, 0x7DE4, 0x7DE6, 0x7DE8, 0x7DE9, 0x7DEC, 0x7DEF,
+	0x7DF1, 0x7DF2, 0x7DF4, 0x7DF9, 0x7DFB, 0x7E01, 0x7E04, 0x7E05,
+	0x7E08, 0x7E09, 0x7E0A, 0x7E0B, 0x7E10, 0x7E11, 0x7E12, 0x7E15,
+	0x7E17, 0x7E1B, 0x7E1D, 0x7E1E, 0x7E1F, 0x7E20, 0x7E21, 0x7E22,
+	0x7E23, 0x7E26, 0x7E27, 0x7E28, 0x7E2B, 0x7E2C, 0x7E2E, 0x7E31,
+	0x7E32, 0x7E35, 0x7E37, 0x7E39, 0x7E3A, 0x7E3B, 0x7E3D, 0x7E3E,
+	0x7E41, 0x7E43, 0x7E45, 0x7E46, 0x7E47, 0x7E4A, 0x7E4B, 0x7E4D,
+	0x7E52, 0x7E54, 0x7E55, 0x7E56, 0x7E59, 0x7E5A, 0x7E5D, 0x7E5E,
+	0x7E61, 0x7E66, 0x7E67, 0x7E69, 0x7E6A, 0x7E6B, 0x7E6D, 0x7E70,
+	0x7E73, 0x7E75, 0x7E79, 0x7E7B, 0x7E7C, 0x7E7D, 0x7E7E, 0x7E7F,
+	0x7E82, 0x7E83, 0x7E86, 0x7E87, 0x7E88, 0x7E89, 0x7E8A, 0x7E8C,
+	0x7E8D, 0x7E8E, 0x7E8F, 0x7E90, 0x7E91, 0x7E92, 0x7E93, 0x7E94,
+	0x7E96, 0x7E98, 0x7E9A, 0x7E9B, 0x7E9C, 0x7F36, 0x7F38, 0x7F3A,
+	0x7F3B, 0x7F3C, 0x7F3E, 0x7F43, 0x7F44, 0x7F45, 0x7F47, 0x7F4C,
+	0x7F4D, 0x7F4E, 0x7F4F, 0x7F50, 0x7F51, 0x7F52, 0x7F54, 0x7F55,
+	0x7F58, 0x7F5F, 0x7F60, 0x7F61, 0x7F63, 0x7F64, 0x7F67, 0x7F68,
+	0x7F69, 0x7F6A, 0x7F6B, 0x7F6D, 0x7F6E, 0x7F70, 0x7F72, 0x7F75,
+	0x7F77, 0x7F78, 0x7F79, 0x7F7D, 0x7F7E, 0x7F82, 0x7F83, 0x7F85,
+	0x7F86, 0x7F87, 0x7F88, 0x7F8A, 0x7F8C, 0x7F8E, 0x7F90, 0x7F91,
+	0x7F94, 0x7F96, 0x7F97, 0x7F9A, 0x7F9C, 0x7F9D, 0x7F9E, 0x7FA3,
+	0x7FA4, 0x7FA8, 0x7FA9, 0x7FAD, 0x7FAE, 0x7FAF, 0x7FB2, 0x7FB6,
+	0x7FB8, 0x7FB9, 0x7FBD, 0x7FBF, 0x7FC1, 0x7FC3, 0x7FC5, 0x7FC6,
+	0x7FCA, 0x7FCC, 0x7FCE, 0x7FCF, 0x7FD2, 0x7FD4, 0x7FD5, 0x7FDB,
+	0x7FDF, 0x7FE0, 0x7FE1, 0x7FE3, 0x7FE5, 0x7FE6, 0x7FE9, 0x7FEB,
+	0x7FEC, 0x7FEE, 0x7FEF, 0x7FF0, 0x7FF2, 0x7FF3, 0x7FF9, 0x7FFA,
+	0x7FFB, 0x7FFC, 0x8000, 0x8001, 0x8002, 0x8003, 0x8004, 0x8005,
+	0x8006, 0x8008, 0x800A, 0x800B, 0x800C, 0x800E, 0x8010, 0x8011,
+	0x8012, 0x8014, 0x8015, 0x8016, 0x8017, 0x8018, 0x8019, 0x801C,
+	0x8021, 0x8024, 0x8026, 0x8028, 0x802C, 0x8030, 0x8033, 0x8035,
+	0x8036, 0x8037, 0x803B, 0x803C, 0x803D, 0x803F, 0x8043, 0x8046,
+	0x804A, 0x8052, 0x8056, 0x8058, 0x805A, 0x805E, 0x805F, 0x8061,
+	0x8062, 0x8066, 0x8068, 0x806F, 0x8070, 0x8071, 0x8072, 0x8073,
+	0x8074, 0x8075, 0x8076, 0x8077, 0x8079, 0x807B, 0x807D, 0x807E,
+	0x807F, 0x8084, 0x8085, 0x8086, 0x8087, 0x8089, 0x808B, 0x808C,
+	0x8093, 0x8096, 0x8098, 0x8099, 0x809A, 0x809B, 0x809C, 0x809D,
+	0x80A1, 0x80A2, 0x80A4, 0x80A5, 0x80A7, 0x80A9, 0x80AA, 0x80AC,
+	0x80AD, 0x80AF, 0x80B1, 0x80B2, 0x80B4, 0x80B8, 0x80BA, 0x80C3,
+	0x80C4, 0x80C5, 0x80C6, 0x80CA, 0x80CC, 0x80CE, 0x80D5, 0x80D6,
+	0x80D7, 0x80D8, 0x80D9, 0x80DA, 0x80DB, 0x80DD, 0x80DE, 0x80E0,
+	0x80E1, 0x80E4, 0x80E5, 0x80E6, 0x80EF, 0x80F1, 0x80F3, 0x80F4,
+	0x80F5, 0x80F8, 0x80FB, 0x80FC, 0x80FD, 0x8102, 0x8105, 0x8106,
+	0x8107, 0x8108, 0x8109, 0x810A, 0x810D, 0x8116, 0x8118, 0x811A,
+	0x811B, 0x811E, 0x8123, 0x8124, 0x8127, 0x8129, 0x812C, 0x812F,
+	0x8131, 0x8133, 0x8135, 0x8139, 0x813D, 0x813E, 0x8146, 0x814A,
+	0x814B, 0x814E, 0x8150, 0x8151, 0x8153, 0x8154, 0x8155, 0x815F,
+	0x8160, 0x8165, 0x8166, 0x8167, 0x8168, 0x8169, 0x816B, 0x816D,
+	0x816E, 0x8170, 0x8171, 0x8174, 0x8178, 0x8179, 0x817A, 0x817F,
+	0x8180, 0x8181, 0x8182, 0x8183, 0x8184, 0x8185, 0x8188, 0x818A,
+	0x818F, 0x8193, 0x8195, 0x8198, 0x819A, 0x819C, 0x819D, 0x81A0,
+	0x81A3, 0x81A4, 0x81A8, 0x81A9, 0x81B0, 0x81B2, 0x81B3, 0x81B5,
+	0x81B8, 0x81BA, 0x81BB, 0x81BD, 0x81BE, 0x81BF, 0x81C0, 0x81C1,
+	0x81C2, 0x81C3, 0x81C6, 0x81C8, 0x81C9, 0x81CA, 0x81CD, 0x81CF,
+	0x81D1, 0x81D3, 0x81D6, 0x81D7, 0x81D8, 0x81D9, 0x81DA, 0x81DB,
+	0x81DF, 0x81E0, 0x81E3, 0x81E4, 0x81E5, 0x81E7, 0x81E8, 0x81EA,
+	0x81EC, 0x81ED, 0x81F3, 0x81F4, 0x81FA, 0x81FB, 0x81FC, 0x81FD,
+	0x81FE, 0x81FF, 0x8201, 0x8202, 0x8204, 0x8205, 0x8207, 0x8208,
+	0x8209, 0x820A, 0x820C, 0x820D, 0x820E, 0x8210, 0x8212, 0x8216,
+	0x8217, 0x8218, 0x8219, 0x821B, 0x821C, 0x821E, 0x821F, 0x8221,
+	0x8222, 0x8229, 0x822A, 0x822B, 0x822C, 0x822E, 0x8232, 0x8233,
+	0x8234, 0x8235, 0x8236, 0x8237, 0x8238, 0x8239, 0x823C, 0x8240,
+	0x8245, 0x8246, 0x8247, 0x8249, 0x824B, 0x824F, 0x8257, 0x8258,
+	0x8259, 0x825A, 0x825C, 0x825D, 0x825F, 0x8260, 0x8262, 0x8263,
+	0x8264, 0x8266, 0x8268, 0x826A, 0x826B, 0x826E, 0x826F, 0x8271,
+	0x8272, 0x8274, 0x8276, 0x8277, 0x8278, 0x8279, 0x827D, 0x827E,
+	0x827F, 0x8283, 0x828A, 0x828B, 0x828D, 0x828E, 0x8292, 0x8293,
+	0x8299, 0x829D, 0x829F, 0x82A1, 0x82A3, 0x82A4, 0x82A5, 0x82A6,
+	0x82A7, 0x82A8, 0x82A9, 0x82AB, 0x82AC, 0x82AD, 0x82AE, 0x82AF,
+	0x82B1, 0x82B2, 0x82B3, 0x82B4, 0x82B7, 0x82B8, 0x82B9, 0x82BA,
+	0x82BB, 0x82BC, 0x82BD, 0x82BE, 0x82BF, 0x82C5, 0x82C6, 0x82D1,
+	0x82D2, 0x82D3, 0x82D4, 0x82D5, 0x82D7, 0x82D9, 0x82DB, 0x82DC,
+	0x82DE, 0x82DF, 0x82E1, 0x82E2, 0x82E3, 0x82E5, 0x82E6, 0x82E7,
+	0x82E8, 0x82EB, 0x82F1, 0x82F3, 0x82F4, 0x82F7, 0x82F9, 0x82FA,
+	0x82FB, 0x82FD, 0x82FE, 0x8300, 0x8301, 0x8302, 0x8303, 0x8304,
+	0x8305, 0x8306, 0x8307, 0x8308, 0x8309, 0x830C, 0x830E, 0x8316,
+	0x8317, 0x8318, 0x831B, 0x831C, 0x831D, 0x8322, 0x8323, 0x8328,
+	0x832B, 0x832D, 0x832F, 0x8330, 0x8331, 0x8332, 0x8334, 0x8335,
+	0x8336, 0x8338, 0x8339, 0x833A, 0x833C, 0x8340, 0x8343, 0x8344,
+	0x8345, 0x8347, 0x8349, 0x834A, 0x834F, 0x8350, 0x8351, 0x8352,
+	0x8354, 0x8355, 0x8357, 0x8358, 0x8362, 0x8363, 0x8373, 0x8375,
+	0x8377, 0x837B, 0x837C, 0x837D, 0x837F, 0x8385, 0x8386, 0x8387,
+	0x8389, 0x838A, 0x838D, 0x838E, 0x8392, 0x8393, 0x8394, 0x8395,
+	0x8396, 0x8398, 0x839A, 0x839B, 0x839D, 0x839E, 0x839F, 0x83A0,
+	0x83A2, 0x83A7, 0x83A8, 0x83A9, 0x83AA, 0x83AB, 0x83B1, 0x83B5,
+	0x83BD, 0x83BF, 0x83C0, 0x83C1, 0x83C5, 0x83C7, 0x83C9, 0x83CA,
+	0x83CC, 0x83CE, 0x83CF, 0x83D0, 0x83D1, 0x83D3, 0x83D4, 0x83D6,
+	0x83D8, 0x83DC, 0x83DD, 0x83DF, 0x83E0, 0x83E1, 0x83E5, 0x83E9,
+	0x83EA, 0x83EB, 0x83EF, 0x83F0, 0x83F1, 0x83F2, 0x83F4, 0x83F7,
+	0x83F9, 0x83FB, 0x83FD, 0x8401, 0x8403, 0x8404, 0x8406, 0x8407,
+	0x840A, 0x840B, 0x840C, 0x840D, 0x840E, 0x840F, 0x8411, 0x8413,
+	0x8415, 0x8417, 0x8420, 0x8422, 0x8429, 0x842A, 0x842C, 0x8431,
+	0x8435, 0x8438, 0x8439, 0x843C, 0x843D, 0x8446, 0x8448, 0x8449,
+	0x844A, 0x844E, 0x844F, 0x8451, 0x8452, 0x8457, 0x8459, 0x845A,
+	0x845B, 0x845C, 0x845F, 0x8461, 0x8462, 0x8463, 0x8465, 0x8466,
+	0x8469, 0x846B, 0x846C, 0x846D, 0x846E, 0x846F, 0x8470, 0x8471,
+	0x8473, 0x8475, 0x8476, 0x8477, 0x8478, 0x8479, 0x847A, 0x847C,
+	0x8481, 0x8482, 0x8484, 0x8485, 0x848B, 0x8490, 0x8494, 0x8497,
+	0x8499, 0x849C, 0x849E, 0x849F, 0x84A1, 0x84A6, 0x84AD, 0x84AF,
+	0x84B2, 0x84B4, 0x84B8, 0x84B9, 0x84BA, 0x84BB, 0x84BC, 0x84BE,
+	0x84BF, 0x84C0, 0x84C1, 0x84C2, 0x84C4, 0x84C6, 0x84C9, 0x84CA,
+	0x84CB, 0x84CD, 0x84CE, 0x84CF, 0x84D0, 0x84D1, 0x84D3, 0x84D6,
+	0x84D9, 0x84DA, 0x84DC, 0x84E7, 0x84EA, 0x84EC, 0x84EE, 0x84EF,
+	0x84F0, 0x84F1, 0x84F4, 0x84FA, 0x84FC, 0x84FD, 0x84FF, 0x8500,
+	0x8506, 0x850C, 0x8511, 0x8513, 0x8514, 0x8515, 0x8517, 0x8518,
+	0x851A, 0x851B, 0x851E, 0x851F, 0x8521, 0x8523, 0x8524, 0x8525,
+	0x8526, 0x852B, 0x852C, 0x852D, 0x852F, 0x8532, 0x8534, 0x8535,
+	0x853D, 0x853E, 0x8540, 0x8541, 0x8543, 0x8548, 0x8549, 0x854A,
+	0x854B, 0x854E, 0x854F, 0x8551, 0x8553, 0x8555, 0x8557, 0x8558,
+	0x8559, 0x855A, 0x855E, 0x8561, 0x8562, 0x8563, 0x8564, 0x8568,
+	0x8569, 0x856A, 0x856D, 0x856F, 0x8577, 0x857A, 0x857B, 0x857D,
+	0x857E, 0x857F, 0x8580, 0x8581, 0x8584, 0x8586, 0x8587, 0x8588,
+	0x858A, 0x858C, 0x858F, 0x8590, 0x8591, 0x8593, 0x8594, 0x8597,
+	0x8599, 0x859B, 0x859C, 0x859D, 0x859F, 0x85A2, 0x85A4, 0x85A6,
+	0x85A8, 0x85A9, 0x85AA, 0x85AB, 0x85AC, 0x85AD, 0x85AE, 0x85AF,
+	0x85B0, 0x85B7, 0x85B9, 0x85BA, 0x85BC, 0x85C1, 0x85C7, 0x85C9,
+	0x85CA, 0x85CB, 0x85CD, 0x85CE, 0x85CF, 0x85D0, 0x85D5, 0x85D8,
+	0x85D9, 0x85DC, 0x85DD, 0x85DF, 0x85E1, 0x85E4, 0x85E5, 0x85E6,
+	0x85E9, 0x85EA, 0x85ED, 0x85F6, 0x85F7, 0x85F9, 0x85FA, 0x85FB,
+	0x85FE, 0x85FF, 0x8600, 0x8602, 0x8604, 0x8605, 0x8606, 0x8607,
+	0x860A, 0x860B, 0x8610, 0x8611, 0x8612, 0x8613, 0x8616, 0x8617,
+	0x8618, 0x861A, 0x861E, 0x8621, 0x8622, 0x8624, 0x8627, 0x8629,
+	0x862D, 0x862F, 0x8630, 0x8638, 0x8639, 0x863C, 0x863F, 0x8640,
+	0x8641, 0x864D, 0x864E, 0x8650, 0x8653, 0x8654, 0x8655, 0x8656,
+	0x8657, 0x865A, 0x865B, 0x865C, 0x865E, 0x865F, 0x8662, 0x8667,
+	0x866B, 0x866C, 0x866F, 0x8671, 0x8675, 0x8677, 0x8679, 0x867A,
+	0x867B, 0x8687, 0x8689, 0x868A, 0x868B, 0x868C, 0x868D, 0x8691,
+	0x8693, 0x8695, 0x8698, 0x869C, 0x869D, 0x86A3, 0x86A4, 0x86A8,
+	0x86A9, 0x86AA, 0x86AB, 0x86AF, 0x86B0, 0x86B1, 0x86B3, 0x86B6,
+	0x86B8, 0x86C1, 0x86C3, 0x86C4, 0x86C6, 0x86C7, 0x86C9, 0x86CB,
+	0x86CD, 0x86CE, 0x86D1, 0x86D4, 0x86D5, 0x86D7, 0x86D9, 0x86DB,
+	0x86DE, 0x86DF, 0x86E3, 0x86E4, 0x86E6, 0x86E9, 0x86EC, 0x86ED,
+	0x86EE, 0x86EF, 0x86F8, 0x86F9, 0x86FA, 0x86FB, 0x86FC, 0x86FD,
+	0x86FE, 0x8700, 0x8702, 0x8703, 0x8705, 0x8706, 0x8707, 0x8708,
+	0x8709, 0x870A, 0x870B, 0x870D, 0x870E, 0x8710, 0x8711, 0x8712,
+	0x8713, 0x8718, 0x8719, 0x871A, 0x871C, 0x871F, 0x8721, 0x8723,
+	0x8725, 0x8729, 0x8731, 0x8734, 0x8737, 0x873A, 0x873B, 0x873E,
+	0x873F, 0x8740, 0x8743, 0x8749, 0x874B, 0x874C, 0x874E, 0x8751,
+	0x8753, 0x8755, 0x8757, 0x8758, 0x8759, 0x875F, 0x8760, 0x8763,
+	0x8764, 0x8765, 0x8766, 0x8768, 0x876A, 0x876E, 0x8771, 0x8772,
+	0x8774, 0x8776, 0x8778, 0x877C, 0x877F, 0x8782, 0x8787, 0x8788,
+	0x8789, 0x878B, 0x878D, 0x8793, 0x879F, 0x87A0, 0x87A2, 0x87A7,
+	0x87AB, 0x87AC, 0x87AD, 0x87AF, 0x87B3, 0x87B5, 0x87BA, 0x87BB,
+	0x87BD, 0x87BE, 0x87C0, 0x87C1, 0x87C4, 0x87C6, 0x87C7, 0x87CB,
+	0x87CE, 0x87D0, 0x87D2, 0x87D6, 0x87DF, 0x87E0, 0x87E3, 0x87E5,
+	0x87E6, 0x87EA, 0x87EB, 0x87EC, 0x87ED, 0x87EF, 0x87F2, 0x87F5,
+	0x87F6, 0x87F7, 0x87F9, 0x87FB, 0x87FE, 0x8801, 0x8803, 0x8805,
+	0x8806, 0x880A, 0x880B, 0x880D, 0x880E, 0x880F, 0x8810, 0x8811,
+	0x8813, 0x8814, 0x8815, 0x8816, 0x881F, 0x8821, 0x8822, 0x8823,
+	0x8827, 0x8828, 0x882E, 0x8831, 0x8832, 0x8836, 0x8839, 0x883B,
+	0x883C, 0x8840, 0x8842, 0x8844, 0x8846, 0x884A, 0x884C, 0x884D,
+	0x8852, 0x8853, 0x8857, 0x8858, 0x8859, 0x885B, 0x885D, 0x885E,
+	0x885F, 0x8861, 0x8862, 0x8863, 0x8864, 0x8868, 0x8869, 0x886B,
+	0x886F, 0x8870, 0x8872, 0x8875, 0x8877, 0x887D, 0x887E, 0x887F,
+	0x8881, 0x8882, 0x8888, 0x888B, 0x888D, 0x8892, 0x8896, 0x8897,
+	0x8898, 0x8899, 0x889E, 0x88A0, 0x88A2, 0x88A4, 0x88AA, 0x88AB,
+	0x88AE, 0x88B0, 0x88B1, 0x88B4, 0x88B5, 0x88B7, 0x88BC, 0x88BD,
+	0x88BE, 0x88BF, 0x88C0, 0x88C1, 0x88C2, 0x88C3, 0x88C4, 0x88C5,
+	0x88CA, 0x88CE, 0x88CF, 0x88D1, 0x88D2, 0x88D3, 0x88D4, 0x88D5,
+	0x88D8, 0x88D9, 0x88DB, 0x88DC, 0x88DD, 0x88DF, 0x88E1, 0x88E8,
+	0x88F0, 0x88F1, 0x88F2, 0x88F3, 0x88F4, 0x88F5, 0x88F8, 0x88F9,
+	0x88FC, 0x88FD, 0x88FE, 0x8901, 0x8902, 0x8904, 0x8907, 0x890A,
+	0x890C, 0x8910, 0x8912, 0x8913, 0x8918, 0x8919, 0x891A, 0x891C,
+	0x891D, 0x891E, 0x8925, 0x8927, 0x892A, 0x892B, 0x8930, 0x8932,
+	0x8936, 0x8937, 0x8938, 0x8939, 0x893B, 0x8940, 0x8941, 0x8942,
+	0x8943, 0x8944, 0x8945, 0x8949, 0x894C, 0x894D, 0x8956, 0x895E,
+	0x895F, 0x8960, 0x8962, 0x8964, 0x8966, 0x896A, 0x896D, 0x896F,
+	0x8972, 0x8974, 0x8977, 0x897E, 0x897F, 0x8980, 0x8981, 0x8983,
+	0x8986, 0x8987, 0x8988, 0x8989, 0x898A, 0x898B, 0x898F, 0x8990,
+	0x8993, 0x8994, 0x8996, 0x8997, 0x8998, 0x899A, 0x899F, 0x89A1,
+	0x89A6, 0x89A7, 0x89A9, 0x89AA, 0x89AC, 0x89AF, 0x89B0, 0x89B2,
+	0x89B3, 0x89B7, 0x89BA, 0x89BD, 0x89BF, 0x89C0, 0x89D2, 0x89D4,
+	0x89D6, 0x89D8, 0x89DA, 0x89DC, 0x89DD, 0x89E3, 0x89E5, 0x89E6,
+	0x89E7, 0x89EB, 0x89F1, 0x89F3, 0x89F4, 0x89F6, 0x89F8, 0x89FD,
+	0x89FF, 0x8A00, 0x8A02, 0x8A03, 0x8A08, 0x8A0A, 0x8A0C, 0x8A0E,
+	0x8A10, 0x8A11, 0x8A12, 0x8A13, 0x8A14, 0x8A15, 0x8A16, 0x8A17,
+	0x8A18, 0x8A1B, 0x8A1D, 0x8A1F, 0x8A21, 0x8A22, 0x8A23, 0x8A25,
+	0x8A2A, 0x8A2D, 0x8A31, 0x8A33, 0x8A34, 0x8A35, 0x8A36, 0x8A37,
+	0x8A3A, 0x8A3B, 0x8A3C, 0x8A3E, 0x8A41, 0x8A45, 0x8A46, 0x8A47,
+	0x8A48, 0x8A4D, 0x8A4E, 0x8A50, 0x8A51, 0x8A52, 0x8A54, 0x8A55,
+	0x8A58, 0x8A5B, 0x8A5D, 0x8A5E, 0x8A60, 0x8A61, 0x8A62, 0x8A63,
+	0x8A66, 0x8A69, 0x8A6B, 0x8A6C, 0x8A6D, 0x8A6E, 0x8A70, 0x8A71,
+	0x8A72, 0x8A73, 0x8A75, 0x8A79, 0x8A7C, 0x8A82, 0x8A84, 0x8A85,
+	0x8A87, 0x8A89, 0x8A8C, 0x8A8D, 0x8A90, 0x8A91, 0x8A93, 0x8A95,
+	0x8A98, 0x8A9A, 0x8A9E, 0x8AA0, 0x8AA1, 0x8AA3, 0x8AA4, 0x8AA5,
+	0x8AA6, 0x8AA7, 0x8AA8, 0x8AAC, 0x8AAD, 0x8AAE, 0x8AB0, 0x8AB2,
+	0x8AB7, 0x8AB9, 0x8ABC, 0x8ABE, 0x8ABF, 0x8AC2, 0x8AC4, 0x8AC7,
+	0x8ACB, 0x8ACC, 0x8ACD, 0x8ACF, 0x8AD0, 0x8AD2, 0x8AD6, 0x8AD7,
+	0x8ADA, 0x8ADB, 0x8ADC, 0x8ADE, 0x8ADF, 0x8AE0, 0x8AE1, 0x8AE2,
+	0x8AE4, 0x8AE6, 0x8AE7, 0x8AEB, 0x8AED, 0x8AEE, 0x8AF1, 0x8AF3,
+	0x8AF4, 0x8AF6, 0x8AF7, 0x8AF8, 0x8AFA, 0x8AFC, 0x8AFE, 0x8B00,
+	0x8B01, 0x8B02, 0x8B04, 0x8B05, 0x8B07, 0x8B0A, 0x8B0C, 0x8B0D,
+	0x8B0E, 0x8B10, 0x8B14, 0x8B16, 0x8B17, 0x8B19, 0x8B1A, 0x8B1B,
+	0x8B1C, 0x8B1D, 0x8B1F, 0x8B20, 0x8B21, 0x8B26, 0x8B28, 0x8B2B,
+	0x8B2C, 0x8B2D, 0x8B33, 0x8B39, 0x8B3E, 0x8B41, 0x8B43, 0x8B46,
+	0x8B49, 0x8B4C, 0x8B4E, 0x8B4F, 0x8B51, 0x8B54, 0x8B56, 0x8B58,
+	0x8B59, 0x8B5A, 0x8B5B, 0x8B5C, 0x8B5E, 0x8B5F, 0x8B66, 0x8B69,
+	0x8B6B, 0x8B6C, 0x8B6F, 0x8B70, 0x8B71, 0x8B72, 0x8B74, 0x8B76,
+	0x8B77, 0x8B7D, 0x8B7F, 0x8B80, 0x8B81, 0x8B83, 0x8B8A, 0x8B8B,
+	0x8B8C, 0x8B8E, 0x8B90, 0x8B92, 0x8B93, 0x8B94, 0x8B95, 0x8B96,
+	0x8B99, 0x8B9A, 0x8B9C, 0x8B9D, 0x8B9E, 0x8C37, 0x8C39, 0x8C3A,
+	0x8C3D, 0x8C3F, 0x8C41, 0x8C45, 0x8C46, 0x8C47, 0x8C48, 0x8C49,
+	0x8C4A, 0x8C4C, 0x8C4E, 0x8C4F, 0x8C50, 0x8C54, 0x8C55, 0x8C57,
+	0x8C5A, 0x8C61, 0x8C62, 0x8C68, 0x8C69, 0x8C6A, 0x8C6B, 0x8C6C,
+	0x8C6D, 0x8C73, 0x8C78, 0x8C79, 0x8C7A, 0x8C7C, 0x8C82, 0x8C85,
+	0x8C89, 0x8C8A, 0x8C8C, 0x8C8D, 0x8C8E, 0x8C92, 0x8C93, 0x8C94,
+	0x8C98, 0x8C99, 0x8C9B, 0x8C9D, 0x8C9E, 0x8CA0, 0x8CA1, 0x8CA2,
+	0x8CA4, 0x8CA7, 0x8CA8, 0x8CA9, 0x8CAA, 0x8CAB, 0x8CAC, 0x8CAD,
+	0x8CAE, 0x8CAF, 0x8CB0, 0x8CB2, 0x8CB3, 0x8CB4, 0x8CB6, 0x8CB7,
+	0x8CB8, 0x8CBB, 0x8CBC, 0x8CBD, 0x8CBF, 0x8CC0, 0x8CC1, 0x8CC2,
+	0x8CC3, 0x8CC4, 0x8CC7, 0x8CC8, 0x8CCA, 0x8CCD, 0x8CCE, 0x8CD1,
+	0x8CD3, 0x8CD5, 0x8CD6, 0x8CD9, 0x8CDA, 0x8CDB, 0x8CDC, 0x8CDE,
+	0x8CE0, 0x8CE1, 0x8CE2, 0x8CE3, 0x8CE4, 0x8CE6, 0x8CEA, 0x8CED,
+	0x8CF0, 0x8CF1, 0x8CF4, 0x8CF8, 0x8CFA, 0x8CFB, 0x8CFC, 0x8CFD,
+	0x8CFE, 0x8D04, 0x8D05, 0x8D07, 0x8D08, 0x8D09, 0x8D0A, 0x8D0B,
+	0x8D0D, 0x8D0E, 0x8D0F, 0x8D10, 0x8D12, 0x8D13, 0x8D14, 0x8D16,
+	0x8D1B, 0x8D64, 0x8D66, 0x8D67, 0x8D6B, 0x8D6C, 0x8D6D, 0x8D70,
+	0x8D71, 0x8D73, 0x8D74, 0x8D77, 0x8D81, 0x8D84, 0x8D85, 0x8D8A,
+	0x8D95, 0x8D99, 0x8DA3, 0x8DA6, 0x8DA8, 0x8DAF, 0x8DB3, 0x8DBA,
+	0x8DBE, 0x8DC2, 0x8DC6, 0x8DC8, 0x8DCB, 0x8DCC, 0x8DCE, 0x8DCF,
+	0x8DD1, 0x8DD6, 0x8DD7, 0x8DD9, 0x8DDA, 0x8DDB, 0x8DDD, 0x8DDF,
+	0x8DE1, 0x8DE3, 0x8DE8, 0x8DEA, 0x8DEB, 0x8DEC, 0x8DEF, 0x8DF3,
+	0x8DF5, 0x8DFC, 0x8DFD, 0x8DFF, 0x8E06, 0x8E08, 0x8E09, 0x8E0A,
+	0x8E0C, 0x8E0F, 0x8E10, 0x8E14, 0x8E16, 0x8E1D, 0x8E1E, 0x8E1F,
+	0x8E20, 0x8E21, 0x8E22, 0x8E23, 0x8E27, 0x8E2A, 0x8E30, 0x8E34,
+	0x8E35, 0x8E36, 0x8E39, 0x8E3D, 0x8E42, 0x8E44, 0x8E47, 0x8E48,
+	0x8E49, 0x8E4A, 0x8E4B, 0x8E4C, 0x8E50, 0x8E54, 0x8E55, 0x8E59,
+	0x8E5F, 0x8E60, 0x8E62, 0x8E63, 0x8E64, 0x8E6C, 0x8E6D, 0x8E6F,
+	0x8E70, 0x8E72, 0x8E74, 0x8E76, 0x8E7B, 0x8E7C, 0x8E81, 0x8E84,
+	0x8E85, 0x8E87, 0x8E8A, 0x8E8B, 0x8E8D, 0x8E91, 0x8E93, 0x8E94,
+	0x8E98, 0x8E99, 0x8E9E, 0x8EA1, 0x8EAA, 0x8EAB, 0x8EAC, 0x8EAE,
+	0x8EAF, 0x8EB0, 0x8EB1, 0x8EB3, 0x8EB5, 0x8EB6, 0x8EBB, 0x8EBE,
+	0x8EC0, 0x8EC5, 0x8EC6, 0x8EC8, 0x8ECA, 0x8ECB, 0x8ECC, 0x8ECD,
+	0x8ED1, 0x8ED2, 0x8ED4, 0x8EDB, 0x8EDF, 0x8EE2, 0x8EE3, 0x8EEB,
+	0x8EF8, 0x8EF9, 0x8EFA, 0x8EFB, 0x8EFC, 0x8EFD, 0x8EFE, 0x8F00,
+	0x8F03, 0x8F05, 0x8F08, 0x8F09, 0x8F0A, 0x8F0C, 0x8F12, 0x8F13,
+	0x8F14, 0x8F15, 0x8F17, 0x8F19, 0x8F1B, 0x8F1C, 0x8F1D, 0x8F1E,
+	0x8F1F, 0x8F26, 0x8F29, 0x8F2A, 0x8F2B, 0x8F2D, 0x8F2F, 0x8F33,
+	0x8F36, 0x8F38, 0x8F39, 0x8F3B, 0x8F3E, 0x8F3F, 0x8F40, 0x8F42,
+	0x8F44, 0x8F45, 0x8F46, 0x8F49, 0x8F4A, 0x8F4C, 0x8F4D, 0x8F4E,
+	0x8F54, 0x8F57, 0x8F58, 0x8F5C, 0x8F5F, 0x8F61, 0x8F62, 0x8F63,
+	0x8F64, 0x8F9B, 0x8F9C, 0x8F9E, 0x8F9F, 0x8FA3, 0x8FA4, 0x8FA6,
+	0x8FA7, 0x8FA8, 0x8FAD, 0x8FAE, 0x8FAF, 0x8FB0, 0x8FB1, 0x8FB2,
+	0x8FB4, 0x8FB5, 0x8FB6, 0x8FB7, 0x8FBA, 0x8FBB, 0x8FBC, 0x8FBF,
+	0x8FC1, 0x8FC2, 0x8FC4, 0x8FC5, 0x8FC6, 0x8FCA, 0x8FCD, 0x8FCE,
+	0x8FD1, 0x8FD3, 0x8FD4, 0x8FD5, 0x8FDA, 0x8FE0, 0x8FE2, 0x8FE4,
+	0x8FE5, 0x8FE6, 0x8FE8, 0x8FE9, 0x8FEA, 0x8FEB, 0x8FED, 0x8FEE,
+	0x8FEF, 0x8FF0, 0x8FF1, 0x8FF4, 0x8FF5, 0x8FF7, 0x8FF8, 0x8FF9,
+	0x8FFA, 0x8FFB, 0x8FFD, 0x9000, 0x9001, 0x9002, 0x9003, 0x9005,
+	0x9006, 0x9008, 0x900B, 0x900C, 0x900D, 0x900E, 0x900F, 0x9010,
+	0x9011, 0x9013, 0x9014, 0x9015, 0x9016, 0x9017, 0x9019, 0x901A,
+	0x901D, 0x901E, 0x901F, 0x9020, 0x9021, 0x9022, 0x9023, 0x9027,
+	0x902D, 0x902E, 0x9031, 0x9032, 0x9035, 0x9036, 0x9037, 0x9038,
+	0x9039, 0x903C, 0x903E, 0x9041, 0x9042, 0x9043, 0x9044, 0x9045,
+	0x9047, 0x9049, 0x904A, 0x904B, 0x904D, 0x904E, 0x904F, 0x9050,
+	0x9051, 0x9052, 0x9053, 0x9054, 0x9055, 0x9056, 0x9058, 0x9059,
+	0x905C, 0x905D, 0x905E, 0x9060, 0x9061, 0x9063, 0x9065, 0x9068,
+	0x9069, 0x906D, 0x906E, 0x906F, 0x9072, 0x9075, 0x9076, 0x9077,
+	0x9078, 0x907A, 0x907C, 0x907D, 0x907F, 0x9080, 0x9081, 0x9082,
+	0x9083, 0x9084, 0x9085, 0x9087, 0x9088, 0x9089, 0x908A, 0x908C,
+	0x908F, 0x9090, 0x9091, 0x9095, 0x9097, 0x9099, 0x909B, 0x90A1,
+	0x90A2, 0x90A3, 0x90A6, 0x90A8, 0x90AA, 0x90AF, 0x90B0, 0x90B1,
+	0x90B3, 0x90B5, 0x90B6, 0x90B8, 0x90BE, 0x90C1, 0x90C3, 0x90C4,
+	0x90C5, 0x90C7, 0x90C8, 0x90CA, 0x90CE, 0x90D7, 0x90DB, 0x90DC,
+	0x90DD, 0x90DE, 0x90DF, 0x90E1, 0x90E2, 0x90E4, 0x90E8, 0x90EB,
+	0x90ED, 0x90EF, 0x90F2, 0x90F4, 0x90F5, 0x90F6, 0x90F7, 0x90FD,
+	0x90FE, 0x90FF, 0x9100, 0x9102, 0x9104, 0x9106, 0x9112, 0x9114,
+	0x9115, 0x9116, 0x9118, 0x9119, 0x911C, 0x911E, 0x9122, 0x9123,
+	0x9127, 0x912D, 0x912F, 0x9130, 0x9131, 0x9132, 0x9134, 0x9137,
+	0x9139, 0x913A, 0x913D, 0x9146, 0x9147, 0x9148, 0x9149, 0x914A,
+	0x914B, 0x914C, 0x914D, 0x914E, 0x9152, 0x9154, 0x9156, 0x9157,
+	0x9158, 0x9159, 0x915B, 0x9161, 0x9162, 0x9163, 0x9164, 0x9165,
+	0x9169, 0x916A, 0x916C, 0x9172, 0x9173, 0x9174, 0x9175, 0x9177,
+	0x9178, 0x9179, 0x9182, 0x9183, 0x9185, 0x9187, 0x9189, 0x918B,
+	0x918D, 0x918E, 0x9190, 0x9192, 0x9197, 0x919C, 0x919E, 0x91A2,
+	0x91A4, 0x91A8, 0x91AA, 0x91AB, 0x91AC, 0x91AE, 0x91AF, 0x91B1,
+	0x91B3, 0x91B4, 0x91B5, 0x91B6, 0x91B8, 0x91BA, 0x91BC, 0x91C0,
+	0x91C1, 0x91C3, 0x91C4, 0x91C6, 0x91C7, 0x91C8, 0x91C9, 0x91CB,
+	0x91CC, 0x91CD, 0x91CE, 0x91CF, 0x91D0, 0x91D1, 0x91D6, 0x91D7,
+	0x91D8, 0x91DA, 0x91DB, 0x91DC, 0x91DD, 0x91DF, 0x91E1, 0x91E3,
+	0x91E4, 0x91E5, 0x91E6, 0x91E7, 0x91EC, 0x91ED, 0x91EE, 0x91F1,
+	0x91F5, 0x91F6, 0x91FB, 0x91FC, 0x91FF, 0x9201, 0x9207, 0x920A,
+	0x920D, 0x920E, 0x9210, 0x9211, 0x9214, 0x9215, 0x9216, 0x9217,
+	0x921E, 0x9229, 0x922C, 0x9233, 0x9234, 0x9237, 0x9238, 0x9239,
+	0x923A, 0x923C, 0x923F, 0x9240, 0x9242, 0x9243, 0x9244, 0x9245,
+	0x9247, 0x9248, 0x9249, 0x924A, 0x924B, 0x924E, 0x924F, 0x9250,
+	0x9251, 0x9256, 0x9257, 0x9259, 0x925A, 0x925B, 0x925E, 0x9260,
+	0x9261, 0x9262, 0x9264, 0x9265, 0x9266, 0x9267, 0x9268, 0x9271,
+	0x9278, 0x927C, 0x927D, 0x927E, 0x927F, 0x9280, 0x9283, 0x9285,
+	0x9288, 0x9289, 0x928D, 0x9291, 0x9293, 0x9295, 0x9296, 0x9297,
+	0x9298, 0x9299, 0x929A, 0x929B, 0x929C, 0x929F, 0x92A7, 0x92AB,
+	0x92AD, 0x92B2, 0x92B7, 0x92B9, 0x92BF, 0x92C0, 0x92C2, 0x92C6,
+	0x92CB, 0x92CC, 0x92CE, 0x92CF, 0x92D0, 0x92D2, 0x92D3, 0x92D7,
+	0x92D9, 0x92E0, 0x92E4, 0x92E5, 0x92E7, 0x92E9, 0x92EA, 0x92ED,
+	0x92F2, 0x92F3, 0x92F7, 0x92F8, 0x92F9, 0x92FA, 0x92FB, 0x92FC,
+	0x92FF, 0x9302, 0x9304, 0x9306, 0x930D, 0x930F, 0x9310, 0x9311,
+	0x9315, 0x9318, 0x9319, 0x931A, 0x931D, 0x931E, 0x931F, 0x9320,
+	0x9321, 0x9322, 0x9323, 0x9325, 0x9326, 0x9327, 0x9328, 0x9329,
+	0x932B, 0x932C, 0x932E, 0x932F, 0x9332, 0x9335, 0x933A, 0x933B,
+	0x9344, 0x9347, 0x9348, 0x9349, 0x934A, 0x934B, 0x934D, 0x9351,
+	0x9354, 0x9356, 0x9357, 0x935A, 0x935B, 0x935C, 0x9360, 0x9364,
+	0x9365, 0x936A, 0x936B, 0x936C, 0x936E, 0x9370, 0x9371, 0x9373,
+	0x9375, 0x937C, 0x937E, 0x9388, 0x938B, 0x938C, 0x938F, 0x9394,
+	0x9396, 0x9397, 0x939A, 0x939B, 0x939E, 0x93A1, 0x93A3, 0x93A7,
+	0x93AC, 0x93AD, 0x93AE, 0x93B0, 0x93B9, 0x93BA, 0x93C1, 0x93C3,
+	0x93C6, 0x93C7, 0x93C8, 0x93D0, 0x93D1, 0x93D6, 0x93D7, 0x93D8,
+	0x93DC, 0x93DD, 0x93DE, 0x93DF, 0x93E1, 0x93E2, 0x93E4, 0x93E5,
+	0x93E7, 0x93E8, 0x93F1, 0x93F5, 0x93FB, 0x93FD, 0x9403, 0x9404,
+	0x9407, 0x9409, 0x940F, 0x9410, 0x9413, 0x9414, 0x9416, 0x9417,
+	0x9418, 0x9419, 0x941A, 0x9421, 0x942B, 0x9432, 0x9433, 0x9434,
+	0x9435, 0x9436, 0x9438, 0x943A, 0x943B, 0x9441, 0x9444, 0x9445,
+	0x944A, 0x9451, 0x9452, 0x9453, 0x945A, 0x945B, 0x945E, 0x9460,
+	0x9462, 0x9463, 0x946A, 0x946B, 0x946D, 0x946F, 0x9470, 0x9471,
+	0x9472, 0x9475, 0x9477, 0x947C, 0x947D, 0x947E, 0x947F, 0x9481,
+	0x9577, 0x9578, 0x9579, 0x9580, 0x9582, 0x9583, 0x9586, 0x9587,
+	0x9589, 0x958A, 0x958B, 0x958C, 0x958D, 0x958E, 0x958F, 0x9591,
+	0x9593, 0x9594, 0x9596, 0x9598, 0x9599, 0x959F, 0x95A0, 0x95A2,
+	0x95A3, 0x95A4, 0x95A5, 0x95A6, 0x95A7, 0x95A8, 0x95A9, 0x95AB,
+	0x95AC, 0x95AD, 0x95B2, 0x95B4, 0x95B6, 0x95B9, 0x95BB, 0x95BC,
+	0x95BD, 0x95BE, 0x95C3, 0x95C7, 0x95C8, 0x95CA, 0x95CB, 0x95CC,
+	0x95CD, 0x95D0, 0x95D3, 0x95D4, 0x95D5, 0x95D6, 0x95D8, 0x95DA,
+	0x95DC, 0x95DE, 0x95E1, 0x95E2, 0x95E5, 0x961C, 0x961D, 0x9621,
+	0x9628, 0x962A, 0x962C, 0x962E, 0x962F, 0x9632, 0x9633, 0x9634,
+	0x963B, 0x963C, 0x963F, 0x9640, 0x9641, 0x9642, 0x9644, 0x964B,
+	0x964C, 0x964D, 0x964F, 0x9650, 0x9658, 0x965B, 0x965C, 0x965D,
+	0x965E, 0x965F, 0x9661, 0x9662, 0x9663, 0x9664, 0x9665, 0x9666,
+	0x966A, 0x966C, 0x9670, 0x9672, 0x9673, 0x9675, 0x9676, 0x9677,
+	0x9678, 0x967A, 0x967D, 0x9682, 0x9684, 0x9685, 0x9686, 0x9688,
+	0x968A, 0x968B, 0x968D, 0x968E, 0x968F, 0x9694, 0x9695, 0x9697,
+	0x9698, 0x9699, 0x969A, 0x969B, 0x969C, 0x969D, 0x96A0, 0x96A3,
+	0x96A4, 0x96A5, 0x96A7, 0x96A8, 0x96A9, 0x96AA, 0x96AF, 0x96B0,
+	0x96B1, 0x96B2, 0x96B3, 0x96B4, 0x96B6, 0x96B7, 0x96B8, 0x96B9,
+	0x96BA, 0x96BB, 0x96BC, 0x96BD, 0x96C0, 0x96C1, 0x96C4, 0x96C5,
+	0x96C6, 0x96C7, 0x96C9, 0x96CB, 0x96CC, 0x96CD, 0x96CE, 0x96D1,
+	0x96D2, 0x96D5, 0x96D6, 0x96D8, 0x96D9, 0x96DA, 0x96DB, 0x96DC,
+	0x96DD, 0x96DE, 0x96E2, 0x96E3, 0x96E8, 0x96E9, 0x96EA, 0x96EB,
+	0x96EF, 0x96F0, 0x96F2, 0x96F6, 0x96F7, 0x96F9, 0x96FB, 0x9700,
+	0x9704, 0x9706, 0x9707, 0x9708, 0x970A, 0x970D, 0x970E, 0x970F,
+	0x9711, 0x9713, 0x9714, 0x9716, 0x9719, 0x971C, 0x971E, 0x9723,
+	0x9724, 0x9727, 0x972A, 0x9730, 0x9732, 0x9733, 0x9736, 0x9738,
+	0x9739, 0x973B, 0x973D, 0x973E, 0x9741, 0x9742, 0x9744, 0x9746,
+	0x9747, 0x9748, 0x9749, 0x974D, 0x974E, 0x974F, 0x9752, 0x9755,
+	0x9756, 0x9757, 0x9759, 0x975A, 0x975B, 0x975C, 0x975E, 0x9760,
+	0x9761, 0x9762, 0x9764, 0x9766, 0x9768, 0x9769, 0x976A, 0x976B,
+	0x976D, 0x976E, 0x9771, 0x9773, 0x9774, 0x9779, 0x977A, 0x977C,
+	0x9781, 0x9784, 0x9785, 0x9786, 0x978B, 0x978D, 0x978F, 0x9790,
+	0x9795, 0x9796, 0x9798, 0x979A, 0x979C, 0x979E, 0x97A0, 0x97A2,
+	0x97A3, 0x97A6, 0x97A8, 0x97AB, 0x97AD, 0x97AE, 0x97B1, 0x97B2,
+	0x97B3, 0x97B4, 0x97BA, 0x97BE, 0x97C1, 0x97C3, 0x97C6, 0x97C8,
+	0x97C9, 0x97CB, 0x97CC, 0x97D1, 0x97D3, 0x97D4, 0x97D8, 0x97D9,
+	0x97DB, 0x97DC, 0x97DE, 0x97E1, 0x97ED, 0x97EE, 0x97F1, 0x97F2,
+	0x97F3, 0x97F4, 0x97F5, 0x97F6, 0x97FB, 0x97FF, 0x9801, 0x9802,
+	0x9803, 0x9804, 0x9805, 0x9806, 0x9808, 0x980A, 0x980C, 0x980D,
+	0x980E, 0x980F, 0x9810, 0x9811, 0x9812, 0x9813, 0x9814, 0x9816,
+	0x9817, 0x9818, 0x981A, 0x981E, 0x9821, 0x9823, 0x9824, 0x9825,
+	0x982B, 0x982C, 0x982D, 0x9830, 0x9832, 0x9833, 0x9834, 0x9837,
+	0x9838, 0x983B, 0x983C, 0x983D, 0x9846, 0x9847, 0x984B, 0x984C,
+	0x984D, 0x984E, 0x984F, 0x9852, 0x9853, 0x9854, 0x9855, 0x9856,
+	0x9857, 0x9858, 0x9859, 0x985A, 0x985B, 0x985E, 0x9865, 0x9866,
+	0x9867, 0x986B, 0x986C, 0x986F, 0x9870, 0x9871, 0x9873, 0x9874,
+	0x98A8, 0x98AA, 0x98AB, 0x98AD, 0x98AF, 0x98B0, 0x98B1, 0x98B6,
+	0x98B7, 0x98B8, 0x98BA, 0x98BB, 0x98BC, 0x98BF, 0x98C2, 0x98C3,
+	0x98C4, 0x98C6, 0x98C7, 0x98C8, 0x98CB, 0x98DB, 0x98DC, 0x98DF,
+	0x98E0, 0x98E1, 0x98E2, 0x98E3, 0x98E5, 0x98E7, 0x98E9, 0x98EA,
+	0x98EB, 0x98ED, 0x98EE, 0x98EF, 0x98F0, 0x98F1, 0x98F2, 0x98F3,
+	0x98F4, 0x98FC, 0x98FD, 0x98FE, 0x9903, 0x9905, 0x9908, 0x9909,
+	0x990A, 0x990C, 0x9910, 0x9912, 0x9913, 0x9914, 0x9916, 0x9917,
+	0x9918, 0x991A, 0x991B, 0x991C, 0x991D, 0x991E, 0x9920, 0x9921,
+	0x9924, 0x9928, 0x992C, 0x992E, 0x9931, 0x9932, 0x9933, 0x993A,
+	0x993B, 0x993C, 0x993D, 0x993E, 0x9940, 0x9941, 0x9942, 0x9945,
+	0x9946, 0x9949, 0x994B, 0x994C, 0x994D, 0x994E, 0x9950, 0x9951,
+	0x9952, 0x9955, 0x9957, 0x9958, 0x995C, 0x995F, 0x9960, 0x9996,
+	0x9997, 0x9998, 0x9999, 0x999E, 0x99A3, 0x99A5, 0x99A6, 0x99A8,
+	0x99AC, 0x99AD, 0x99AE, 0x99B3, 0x99B4, 0x99B9, 0x99BC, 0x99BD,
+	0x99BF, 0x99C1, 0x99C3, 0x99C4, 0x99C5, 0x99C6, 0x99C8, 0x99C9,
+	0x99D0, 0x99D1, 0x99D2, 0x99D4, 0x99D5, 0x99D8, 0x99D9, 0x99DB,
+	0x99DD, 0x99DE, 0x99DF, 0x99E2, 0x99ED, 0x99EE, 0x99F0, 0x99F1,
+	0x99F2, 0x99F8, 0x99F9, 0x99FB, 0x99FC, 0x99FF, 0x9A01, 0x9A02,
+	0x9A03, 0x9A05, 0x9A0A, 0x9A0E, 0x9A0F, 0x9A11, 0x9A12, 0x9A13,
+	0x9A16, 0x9A19, 0x9A1A, 0x9A20, 0x9A24, 0x9A28, 0x9A2B, 0x9A2D,
+	0x9A2E, 0x9A30, 0x9A31, 0x9A36, 0x9A37, 0x9A38, 0x9A3E, 0x9A40,
+	0x9A42, 0x9A43, 0x9A44, 0x9A45, 0x9A4A, 0x9A4C, 0x9A4D, 0x9A4E,
+	0x9A52, 0x9A55, 0x9A57, 0x9A58, 0x9A5A, 0x9A5B, 0x9A5F, 0x9A62,
+	0x9A64, 0x9A65, 0x9A69, 0x9A6A, 0x9A6B, 0x9AA8, 0x9AAD, 0x9AAF,
+	0x9AB0, 0x9AB6, 0x9AB7, 0x9AB8, 0x9AB9, 0x9ABC, 0x9AC0, 0x9AC1,
+	0x9AC3, 0x9AC4, 0x9AC6, 0x9ACE, 0x9ACF, 0x9AD0, 0x9AD1, 0x9AD2,
+	0x9AD3, 0x9AD4, 0x9AD5, 0x9AD6, 0x9AD8, 0x9ADC, 0x9ADE, 0x9ADF,
+	0x9AE0, 0x9AE2, 0x9AE3, 0x9AE5, 0x9AE6, 0x9AE9, 0x9AEA, 0x9AEB,
+	0x9AED, 0x9AEE, 0x9AEF, 0x9AF1, 0x9AF4, 0x9AF7, 0x9AF9, 0x9AFB,
+	0x9B02, 0x9B03, 0x9B06, 0x9B08, 0x9B0C, 0x9B10, 0x9B12, 0x9B16,
+	0x9B18, 0x9B1A, 0x9B1C, 0x9B1F, 0x9B20, 0x9B22, 0x9B23, 0x9B25,
+	0x9B27, 0x9B28, 0x9B29, 0x9B2A, 0x9B2B, 0x9B2D, 0x9B2E, 0x9B2F,
+	0x9B31, 0x9B32, 0x9B33, 0x9B3B, 0x9B3C, 0x9B3D, 0x9B41, 0x9B42,
+	0x9B43, 0x9B44, 0x9B45, 0x9B4B, 0x9B4D, 0x9B4E, 0x9B4F, 0x9B51,
+	0x9B54, 0x9B58, 0x9B5A, 0x9B5E, 0x9B63, 0x9B65, 0x9B66, 0x9B6B,
+	0x9B6C, 0x9B6F, 0x9B72, 0x9B73, 0x9B74, 0x9B75, 0x9B76, 0x9B77,
+	0x9B79, 0x9B83, 0x9B84, 0x9B8A, 0x9B8E, 0x9B8F, 0x9B91, 0x9B92,
+	0x9B93, 0x9B96, 0x9B97, 0x9B9E, 0x9B9F, 0x9BA0, 0x9BA6, 0x9BA7,
+	0x9BA8, 0x9BAA, 0x9BAB, 0x9BAC, 0x9BAD, 0x9BAE, 0x9BB1, 0x9BB2,
+	0x9BB4, 0x9BB8, 0x9BB9, 0x9BBE, 0x9BC0, 0x9BC1, 0x9BC6, 0x9BC7,
+	0x9BC9, 0x9BCA, 0x9BCE, 0x9BCF, 0x9BD1, 0x9BD2, 0x9BD4, 0x9BD6,
+	0x9BD8, 0x9BDB, 0x9BDD, 0x9BE1, 0x9BE2, 0x9BE3, 0x9BE4, 0x9BE5,
+	0x9BE7, 0x9BE8, 0x9BEA, 0x9BEB, 0x9BEE, 0x9BEF, 0x9BF0, 0x9BF1,
+	0x9BF2, 0x9BF3, 0x9BF5, 0x9BF7, 0x9BF8, 0x9BFA, 0x9BFD, 0x9C00,
+	0x9C04, 0x9C06, 0x9C08, 0x9C09, 0x9C0A, 0x9C0C, 0x9C0D, 0x9C10,
+	0x9C12, 0x9C13, 0x9C14, 0x9C15, 0x9C16, 0x9C18, 0x9C19, 0x9C1A,
+	0x9C1B, 0x9C1D, 0x9C21, 0x9C22, 0x9C23, 0x9C24, 0x9C25, 0x9C27,
+	0x9C29, 0x9C2A, 0x9C2D, 0x9C2E, 0x9C2F, 0x9C30, 0x9C31, 0x9C32,
+	0x9C36, 0x9C37, 0x9C39, 0x9C3A, 0x9C3B, 0x9C3E, 0x9C41, 0x9C45,
+	0x9C46, 0x9C47, 0x9C48, 0x9C49, 0x9C4A, 0x9C4F, 0x9C50, 0x9C52,
+	0x9C53, 0x9C54, 0x9C57, 0x9C58, 0x9C5A, 0x9C5B, 0x9C5C, 0x9C5D,
+	0x9C5F, 0x9C60, 0x9C63, 0x9C65, 0x9C67, 0x9C69, 0x9C6A, 0x9C6B,
+	0x9C6D, 0x9C6E, 0x9C70, 0x9C72, 0x9C75, 0x9C76, 0x9C77, 0x9C78,
+	0x9C7A, 0x9CE5, 0x9CE6, 0x9CE7, 0x9CE9, 0x9CEB, 0x9CEC, 0x9CF0,
+	0x9CF2, 0x9CF3, 0x9CF4, 0x9CF6, 0x9D02, 0x9D03, 0x9D06, 0x9D07,
+	0x9D08, 0x9D09, 0x9D0B, 0x9D0E, 0x9D11, 0x9D12, 0x9D15, 0x9D17,
+	0x9D18, 0x9D1B, 0x9D1D, 0x9D1E, 0x9D1F, 0x9D23, 0x9D26, 0x9D28,
+	0x9D2A, 0x9D2B, 0x9D2C, 0x9D32, 0x9D3B, 0x9D3E, 0x9D3F, 0x9D41,
+	0x9D42, 0x9D43, 0x9D44, 0x9D46, 0x9D47, 0x9D48, 0x9D4A, 0x9D50,
+	0x9D51, 0x9D52, 0x9D59, 0x9D5C, 0x9D5D, 0x9D5E, 0x9D5F, 0x9D60,
+	0x9D61, 0x9D62, 0x9D63, 0x9D64, 0x9D69, 0x9D6B, 0x9D6C, 0x9D6F,
+	0x9D70, 0x9D72, 0x9D73, 0x9D76, 0x9D77, 0x9D7A, 0x9D7C, 0x9D7E,
+	0x9D84, 0x9D87, 0x9D89, 0x9D8A, 0x9D8D, 0x9D8F, 0x9D96, 0x9D99,
+	0x9D9A, 0x9DA1, 0x9DA4, 0x9DA9, 0x9DAB, 0x9DAC, 0x9DAF, 0x9DB2,
+	0x9DB4, 0x9DB5, 0x9DB8, 0x9DB9, 0x9DBA, 0x9DBB, 0x9DBC, 0x9DBD,
+	0x9DBF, 0x9DC0, 0x9DC1, 0x9DC2, 0x9DC3, 0x9DC4, 0x9DC6, 0x9DC7,
+	0x9DC9, 0x9DCF, 0x9DD3, 0x9DD6, 0x9DD7, 0x9DD9, 0x9DDA, 0x9DDF,
+	0x9DE0, 0x9DE3, 0x9DE6, 0x9DE7, 0x9DED, 0x9DEF, 0x9DF2, 0x9DF4,
+	0x9DF8, 0x9DF9, 0x9DFA, 0x9DFD, 0x9E02, 0x9E07, 0x9E0A, 0x9E0D,
+	0x9E15, 0x9E19, 0x9E1A, 0x9E1B, 0x9E1C, 0x9E1D, 0x9E1E, 0x9E75,
+	0x9E78, 0x9E79, 0x9E7B, 0x9E7C, 0x9E7D, 0x9E7F, 0x9E80, 0x9E81,
+	0x9E85, 0x9E88, 0x9E8B, 0x9E8C, 0x9E91, 0x9E92, 0x9E93, 0x9E95,
+	0x9E97, 0x9E9B, 0x9E9D, 0x9E9E, 0x9E9F, 0x9EA4, 0x9EA5, 0x9EA6,
+	0x9EA8, 0x9EA9, 0x9EAA, 0x9EAC, 0x9EAD, 0x9EAF, 0x9EB4, 0x9EB5,
+	0x9EB8, 0x9EB9, 0x9EBA, 0x9EBB, 0x9EBC, 0x9EBD, 0x9EBE, 0x9EBF,
+	0x9EC3, 0x9EC4, 0x9ECC, 0x9ECD, 0x9ECE, 0x9ECF, 0x9ED0, 0x9ED1,
+	0x9ED2, 0x9ED4, 0x9ED8, 0x9ED9, 0x9EDB, 0x9EDC, 0x9EDD, 0x9EDE,
+	0x9EDF, 0x9EE0, 0x9EE5, 0x9EE7, 0x9EE8, 0x9EEE, 0x9EEF, 0x9EF4,
+	0x9EF6, 0x9EF7, 0x9EF9, 0x9EFB, 0x9EFC, 0x9EFD, 0x9EFF, 0x9F02,
+	0x9F03, 0x9F07, 0x9F08, 0x9F0E, 0x9F10, 0x9F13, 0x9F15, 0x9F17,
+	0x9F19, 0x9F20, 0x9F21, 0x9F2C, 0x9F2F, 0x9F37, 0x9F39, 0x9F3A,
+	0x9F3B, 0x9F3D, 0x9F3E, 0x9F41, 0x9F45, 0x9F46, 0x9F4A, 0x9F4B,
+	0x9F4E, 0x9F4F, 0x9F52, 0x9F53, 0x9F54, 0x9F55, 0x9F57, 0x9F58,
+	0x9F5D, 0x9F5F, 0x9F60, 0x9F61, 0x9F62, 0x9F63, 0x9F66, 0x9F67,
+	0x9F69, 0x9F6A, 0x9F6C, 0x9F6D, 0x9F70, 0x9F72, 0x9F75, 0x9F76,
+	0x9F77, 0x9F8D, 0x9F90, 0x9F94, 0x9F95, 0x9F97, 0x9F9C, 0x9F9D,
+	0x9FA0, 0x9FA2, 0xFA0F, 0xFA11, 0xFA13, 0xFA14, 0xFA1F, 0xFA21,
+	0xFA24, 0x2000B, 0x20089, 0x200A2, 0x200A4, 0x201A2, 0x20213, 0x2032B,
+	0x20371, 0x20381, 0x203F9, 0x2044A, 0x20509, 0x205D6, 0x20628, 0x2074F,
+	0x20807, 0x2083A, 0x208B9, 0x2097C, 0x2099D, 0x20AD3, 0x20B1D, 0x20B9F,
+	0x20D45, 0x20DE1, 0x20E64, 0x20E6D, 0x20E95, 0x20F5F, 0x21201, 0x2123D,
+	0x21255, 0x21274, 0x2127B, 0x212D7, 0x212E4, 0x212FD, 0x2131B, 0x21336,
+	0x21344, 0x213C4, 0x2146D, 0x2146E, 0x215D7, 0x21647, 0x216B4, 0x21706,
+	0x21742, 0x218BD, 0x219C3, 0x21C56, 0x21D2D, 0x21D45, 0x21D62, 0x21D78,
+	0x21D92, 0x21D9C, 0x21DA1, 0x21DB7, 0x21DE0, 0x21E33, 0x21E34, 0x21F1E,
+	0x21F76, 0x21FFA, 0x2217B, 0x22218, 0x2231E, 0x223AD, 0x226F3, 0x2285B,
+	0x228AB, 0x2298F, 0x22AB8, 0x22B46, 0x22B4F, 0x22B50, 0x22BA6, 0x22C1D,
+	0x22C24, 0x22DE1, 0x231B6, 0x231C3, 0x231C4, 0x231F5, 0x23372, 0x233D0,
+	0x233D2, 0x233D3, 0x233D5, 0x233DA, 0x233DF, 0x233E4, 0x2344A, 0x2344B,
+	0x23451, 0x23465, 0x234E4, 0x2355A, 0x23594, 0x235C4, 0x23638, 0x23639,
+	0x2363A, 0x23647, 0x2370C, 0x2371C, 0x2373F, 0x23763, 0x23764, 0x237E7,
+	0x237FF, 0x23824, 0x2383D, 0x23A98, 0x23C7F, 0x23CFE, 0x23D00, 0x23D0E,
+	0x23D40, 0x23DD3, 0x23DF9, 0x23DFA, 0x23F7E, 0x24096, 0x24103, 0x241C6,
+	0x241FE, 0x243BC, 0x24629, 0x246A5, 0x247F1, 0x24896, 0x24A4D, 0x24B56,
+	0x24B6F, 0x24C16, 0x24D14, 0x24E0E, 0x24E37, 0x24E6A, 0x24E8B, 0x2504A,
+	0x25055, 0x25122, 0x251A9, 0x251CD, 0x251E5, 0x2521E, 0x2524C, 0x2542E,
+	0x2548E, 0x254D9, 0x2550E, 0x255A7, 0x25771, 0x257A9, 0x257B4, 0x259C4,
+	0x259D4, 0x25AE3, 0x25AE4, 0x25AF1, 0x25BB2, 0x25C4B, 0x25C64, 0x25DA1,
+	0x25E2E, 0x25E56, 0x25E62, 0x25E65, 0x25EC2, 0x25ED8, 0x25EE8, 0x25F23,
+	0x25F5C, 0x25FD4, 0x25FE0, 0x25FFB, 0x2600C, 0x26017, 0x26060, 0x260ED,
+	0x26270, 0x26286, 0x2634C, 0x26402, 0x2667E, 0x266B0, 0x2671D, 0x268DD,
+	0x268EA, 0x26951, 0x2696F, 0x269DD, 0x26A1E, 0x26A58, 0x26A8C, 0x26AB7,
+	0x26AFF, 0x26C29, 0x26C73, 0x26CDD, 0x26E40, 0x26E65, 0x26F94, 0x26FF6,
+	0x26FF7, 0x26FF8, 0x270F4, 0x2710D, 0x27139, 0x273DA, 0x273DB, 0x273FE,
+	0x27410, 0x27449, 0x27614, 0x27615, 0x27631, 0x27684, 0x27693, 0x2770E,
+	0x27723, 0x27752, 0x27985, 0x27A84, 0x27BB3, 0x27BBE, 0x27BC7, 0x27CB8,
+	0x27DA0, 0x27E10, 0x27FB7, 0x2808A, 0x280BB, 0x28277, 0x28282, 0x282F3,
+	0x283CD, 0x2840C, 0x28455, 0x2856B, 0x285C8, 0x285C9, 0x286D7, 0x286FA,
+	0x28946, 0x28949, 0x2896B, 0x28987, 0x28988, 0x289BA, 0x289BB, 0x28A1E,
+	0x28A29, 0x28A43, 0x28A71, 0x28A99, 0x28ACD, 0x28ADD, 0x28AE4, 0x28BC1,
+	0x28BEF, 0x28D10, 0x28D71, 0x28DFB, 0x28E1F, 0x28E36, 0x28E89, 0x28EEB,
+	0x28F32, 0x28FF8, 0x292A0, 0x292B1, 0x29490, 0x295CF, 0x2967F, 0x296F0,
+	0x29719, 0x29750, 0x298C6, 0x29A72, 0x29DDB, 0x29E15, 0x29E3D, 0x29E49,
+	0x29E8A, 0x29EC4, 0x29EDB, 0x29EE9, 0x29FCE, 0x2A01A, 0x2A02F, 0x2A082,
+	0x2A0F9, 0x2A190, 0x2A38C, 0x2A437, 0x2A5F1, 0x2A602, 0x2A61A, 0x2A6B2,
+}
+
+// jisPositions is the position of each kanji in jisRunes.
+// The position of 面-区-点 is ((面-1)*94+区-1)*94+点.
+var jisPositions = [9976]uint16{
+	1225, 8849, 8854, 8855, 8898, 8890, 8930, 1273, 9035, 16710, 9039, 1281, 9064, 9085, 9086, 9089,
+	9095, 9121, 9124, 9133, 9142, 9152, 9160, 9207, 9243, 9247, 9245, 9267, 9497, 9499, 9503, 9502,
+	9506, 4387, 9524, 4403, 9548, 9551, 9554, 9556, 9573, 9575, 9578, 9583, 9585, 9897, 9908, 9912,
+	9934, 10023, 10027, 10045, 10080, 10056, 10072, 10076, 10078, 7937, 10135, 10127, 10160, 10161, 10164, 10172,
+	7990, 7991, 10192, 10213, 8016, 10239, 16101, 16108, 16155, 16168, 16200, 16220, 16248, 16255, 16270, 8154,
+	16293, 16295, 16296, 16303, 16310, 16376, 8223, 16394, 16396, 16416, 16427, 16432, 16452, 16463, 16475, 16518,
+	16534, 16537, 16543, 16563, 16567, 16583, 16587, 16584, 16595, 16599, 8349, 16649, 16660, 16665, 16684, 16699,
+	16703, 16762, 16766, 16772, 16774, 16776, 16779, 8423, 8424, 16808, 16810, 16819, 16847, 16844, 16846, 16883,
+	16878, 16903, 16904, 8509, 16967, 16996, 8524, 17018, 17040, 17049, 17060, 17065, 17089, 17117, 17139, 17149,
+	8597, 17168, 17209, 8704, 17370, 17376, 17381, 17384, 17413, 17443, 17490, 17492, 17494, 17501, 8772, 17515,
+	17588, 17591, 17621, 17642, 1486, 3286, 8838, 2561, 4040, 2796, 2460, 2795, 1626, 3812, 4167, 8839,
+	4420, 1519, 8840, 1770, 4421, 2918, 4640, 1934, 3880, 2797, 4260, 3888, 1226, 8841, 4422, 8842,
+	16276, 3266, 8843, 1227, 1228, 4423, 2064, 4424, 1847, 3212, 2609, 4425, 4426, 8844, 4427, 3593,
+	1935, 8845, 8846, 3595, 3545, 2197, 3967, 8848, 6809, 4428, 2798, 4429, 1619, 8851, 2043, 2242,
+	4115, 4988, 8852, 4216, 3571, 1791, 1901, 4430, 4431, 4257, 4165, 3080, 4433, 2530, 3561, 1229,
+	4436, 1534, 2225, 2224, 1480, 4367, 4366, 2369, 1411, 8853, 4437, 4438, 4439, 3968, 4440, 2244,
+	1481, 4030, 1972, 1973, 1974, 3356, 4258, 4441, 4442, 4443, 8856, 2865, 8857, 8859, 2658, 2866,
+	4448, 8860, 4446, 4447, 1936, 8861, 2353, 1678, 4445, 4444, 3863, 8862, 2482, 2481, 3132, 4449,
+	3813, 2985, 8864, 24, 4450, 4452, 1230, 3171, 4295, 1452, 8863, 4451, 1628, 2010, 8865, 3267,
+	8866, 2147, 4453, 3576, 1231, 1232, 8867, 1861, 1233, 4454, 1453, 1234, 2226, 1862, 3850, 3684,
+	1937, 8868, 1679, 4489, 3417, 3648, 4456, 3694, 4296, 8870, 2833, 2483, 2531, 1630, 8871, 3339,
+	3198, 4460, 1236, 1237, 1454, 3357, 2659, 2370, 4140, 3148, 8872, 1629, 1238, 4459, 8873, 4166,
+	4455, 4457, 2428, 4458, 4892, 1239, 1235, 8869, 4466, 1240, 1241, 4469, 4467, 1632, 3881, 4461,
+	8875, 8876, 8877, 4465, 2245, 8878, 1242, 2484, 8879, 1792, 8880, 4470, 4462, 1243, 4297, 2532,
+	4463, 4468, 8881, 1244, 4471, 1245, 4464, 8882, 1975, 1455, 1976, 1631, 4893, 4037, 3838, 2246,
+	8884, 2835, 4253, 8885, 3910, 2092, 3107, 1666, 8886, 1247, 2683, 8887, 4475, 8888, 4480, 4478,
+	8889, 4473, 3117, 4476, 4479, 4477, 3915, 4474, 1248, 2834, 4031, 4481, 4482, 8883, 2636, 4495,
+	1223, 8891, 3620, 3782, 4490, 3934, 1620, 4494, 8892, 1249, 1250, 4488, 4497, 3058, 2198, 3632,
+	1251, 5961, 8893, 4496, 3445, 8894, 4485, 2248, 1252, 2247, 4483, 8895, 8896, 2596, 4491, 8897,
+	3933, 3241, 4487, 2149, 1253, 4484, 4492, 4486, 4279, 4493, 4357, 1254, 2044, 2148, 1256, 1255,
+	1257, 8899, 4498, 8900, 4499, 4503, 1456, 8901, 3902, 4502, 8902, 4501, 4505, 8903, 4504, 3358,
+	8904, 2150, 8905, 8906, 4506, 8907, 2575, 3108, 3359, 2060, 4507, 1902, 4508, 4510, 8909, 3969,
+	2138, 8910, 8911, 1258, 8912, 8913, 2461, 3753, 4509, 8914, 8908, 8915, 2387, 4171, 8917, 4512,
+	4515, 4511, 2386, 2725, 8918, 8919, 2093, 4516, 8920, 2021, 8921, 4513, 4514, 1259, 3493, 8924,
+	3100, 1261, 1977, 8926, 3993, 4517, 8927, 4259, 4518, 8928, 4521, 4519, 1262, 3054, 8929, 4520,
+	4522, 1260, 8922, 4524, 4523, 3894, 1903, 4526, 4527, 1265, 1613, 1264, 9025, 4525, 1266, 2622,
+	4530, 4529, 4528, 9027, 4472, 4531, 9028, 1267, 2726, 4532, 4141, 4099, 1268, 4534, 4533, 4536,
+	4535, 4537, 4538, 1494, 2183, 2095, 2660, 3287, 1978, 2986, 2249, 1269, 2334, 4540, 4082, 3422,
+	2533, 4539, 4541, 1271, 1272, 3446, 1777, 9030, 4542, 3572, 3028, 4544, 4545, 3678, 2250, 4351,
+	4546, 1980, 9032, 3882, 3124, 2055, 3405, 16722, 2151, 4547, 4548, 9033, 3544, 1567, 4551, 2443,
+	9034, 4550, 2388, 4552, 6564, 4553, 3981, 4554, 4555, 4556, 2799, 9036, 2582, 1274, 1793, 4559,
+	9037, 4557, 4071, 4558, 3818, 4560, 4561, 4562, 3447, 9038, 4566, 4564, 4565, 4563, 2417, 4567,
+	4116, 4298, 9040, 4568, 1275, 2922, 4569, 2690, 4570, 1276, 3288, 4261, 3448, 4643, 4571, 7807,
+	2011, 1277, 4572, 4010, 1278, 2704, 3194, 4574, 3546, 4575, 1279, 4576, 1706, 9043, 9044, 4577,
+	1981, 3522, 1594, 2680, 3667, 4578, 3449, 1280, 9045, 2867, 4579, 3866, 2972, 1788, 1795, 4580,
+	4582, 2094, 9046, 1282, 4581, 1283, 9047, 4313, 2705, 3695, 3898, 4583, 4224, 4584, 4585, 3478,
+	4586, 2923, 2444, 2152, 4587, 2485, 2335, 3360, 4589, 3109, 1284, 2429, 4590, 4591, 3024, 4588,
+	4593, 9049, 3970, 1285, 2324, 9050, 1410, 4592, 1286, 2153, 2412, 3649, 4596, 4594, 9051, 3851,
+	2800, 4603, 1760, 4597, 4595, 3055, 9052, 4599, 4598, 9054, 1728, 9053, 2132, 4604, 4243, 4600,
+	4605, 4602, 1287, 4601, 9056, 4277, 2251, 1633, 4314, 9058, 9059, 2717, 3440, 2325, 4608, 4609,
+	9060, 4299, 4335, 4611, 2252, 9061, 9062, 4610, 1707, 4612, 4002, 3318, 4142, 1288, 3911, 9063,
+	1290, 4613, 1291, 9065, 7479, 3494, 1292, 4614, 1796, 4061, 1293, 2727, 4615, 3925, 4619, 2924,
+	4616, 2022, 4617, 1797, 1295, 2082, 4620, 4621, 9067, 4622, 4623, 2597, 1296, 2253, 4104, 1297,
+	4114, 3565, 3935, 4624, 1298, 4625, 9068, 9069, 4627, 4629, 4628, 4630, 1627, 3992, 2442, 4631,
+	1299, 3065, 2728, 1983, 4632, 9070, 3725, 4633, 4634, 4635, 9071, 4636, 3764, 2046, 1479, 9072,
+	3509, 4637, 2661, 9073, 2987, 4639, 4638, 2729, 2227, 4641, 3696, 4642, 3726, 3122, 3180, 1982,
+	3556, 3213, 3650, 3994, 4644, 2988, 1301, 1302, 2089, 9076, 4645, 9077, 4646, 1516, 1495, 1863,
+	3110, 1929, 4217, 4649, 1621, 9078, 4648, 1303, 1984, 4650, 4123, 1304, 4651, 4280, 2254, 1305,
+	2184, 4652, 9079, 4654, 4653, 2883, 1529, 1566, 4655, 4656, 1306, 2185, 9080, 4657, 9082, 1958,
+	9083, 2462, 4658, 4032, 2371, 1938, 4143, 3056, 3697, 2631, 2671, 9087, 2610, 2623, 2718, 9088,
+	3698, 4661, 1539, 3057, 2255, 2199, 2045, 4665, 3197, 3196, 1985, 2730, 4666, 4664, 1634, 3172,
+	2562, 2487, 1510, 9090, 9091, 1772, 2326, 2486, 4667, 4668, 1921, 1730, 2327, 1920, 3353, 1509,
+	3495, 4072, 2257, 4225, 3423, 2256, 1307, 2083, 4677, 4418, 2041, 3990, 9093, 3727, 1308, 9094,
+	4676, 1848, 4671, 4672, 4674, 4675, 1939, 2885, 3867, 4673, 4669, 2229, 4670, 4328, 9096, 3936,
+	3362, 2228, 2336, 1309, 4678, 3539, 9092, 4682, 9097, 9098, 2632, 2624, 1311, 9099, 4685, 4683,
+	4045, 1312, 4680, 4689, 4684, 4687, 2200, 4073, 1313, 4688, 4690, 4692, 1314, 9100, 2430, 4358,
+	9101, 4681, 4679, 4691, 4686, 9102, 1315, 1310, 9103, 1316, 4694, 4703, 4696, 9104, 4700, 1317,
+	4701, 4697, 9105, 9106, 4730, 2423, 1709, 9107, 4695, 9108, 4705, 1496, 4704, 9109, 1415, 3802,
+	4702, 9110, 4698, 1318, 4693, 4699, 2390, 4706, 1497, 4715, 4707, 4708, 2731, 4021, 9112, 4713,
+	4712, 9113, 9114, 3399, 9115, 4714, 4711, 1319, 9116, 9117, 1524, 2372, 2836, 9118, 1320, 4709,
+	3450, 4710, 1412, 1321, 4720, 4139, 2733, 4726, 1322, 4725, 4716, 9119, 3137, 4717, 9120, 3181,
+	4722, 2732, 9122, 9123, 4719, 4110, 1323, 2096, 4723, 4724, 4721, 4727, 1324, 9125, 9126, 4718,
+	9127, 4733, 4738, 9129, 4734, 4729, 1325, 9130, 4739, 3025, 1326, 4741, 9131, 2258, 4731, 3289,
+	1327, 9132, 4735, 4728, 1799, 1864, 1761, 4736, 4732, 2154, 4742, 4740, 3059, 1922, 1986, 1329,
+	4737, 2058, 1540, 9137, 4746, 4744, 4801, 9138, 9139, 1330, 9140, 9134, 4749, 4743, 4747, 9141,
+	4745, 9143, 2488, 4748, 9145, 4751, 4756, 4754, 4753, 3214, 9148, 1332, 1635, 1333, 9149, 4750,
+	4752, 2734, 1523, 4755, 9146, 1660, 4766, 9150, 2818, 4762, 4760, 4761, 9151, 4763, 1334, 9153,
+	9154, 9155, 1533, 9156, 1335, 3033, 4757, 4758, 7809, 1782, 9157, 9158, 4765, 1337, 1865, 4768,
+	4764, 4767, 9159, 1339, 1340, 1341, 3868, 1336, 3531, 3690, 4770, 4769, 1729, 9161, 4771, 9162,
+	4774, 4773, 9163, 1342, 9164, 9165, 1343, 4772, 3597, 4775, 9166, 1344, 1345, 9167, 4776, 9168,
+	1346, 4778, 4777, 4780, 4783, 4781, 4779, 4782, 9169, 4784, 1347, 1348, 9170, 4785, 4786, 4787,
+	4788, 4549, 2630, 2489, 1681, 9171, 1498, 3232, 9172, 4789, 2354, 1457, 2882, 9173, 9174, 4790,
+	2201, 2337, 4792, 4791, 3918, 4793, 4795, 4794, 1349, 4796, 4797, 2155, 1568, 4798, 9176, 4800,
+	4799, 4802, 3442, 1351, 9177, 4803, 1431, 2413, 9179, 2097, 1352, 3243, 1353, 1354, 4804, 4805,
+	4807, 4808, 2418, 9181, 9182, 2023, 3971, 9183, 9184, 4806, 4809, 2383, 2259, 4813, 2355, 3215,
+	9186, 4810, 3348, 9187, 1355, 9188, 9189, 9190, 1356, 9191, 1357, 4814, 2886, 4812, 4815, 2099,
+	4816, 9195, 1358, 9196, 9197, 4817, 2260, 1724, 4819, 9198, 4820, 9194, 4821, 4818, 1361, 4811,
+	4822, 4823, 1362, 1363, 4016, 9201, 2801, 1364, 4825, 4826, 4824, 4828, 9199, 3596, 9204, 1482,
+	3814, 4829, 1365, 9205, 1366, 9206, 2819, 1367, 1368, 2563, 9208, 3633, 1866, 9209, 2425, 9210,
+	1369, 4005, 3496, 9211, 2156, 3149, 1370, 4827, 4830, 3138, 4831, 4832, 9212, 9213, 4834, 3363,
+	9214, 1800, 7803, 1569, 3937, 9215, 2802, 3424, 9216, 2420, 4840, 3883, 4291, 9218, 1682, 4836,
+	9219, 3034, 4839, 3451, 3425, 3452, 3691, 3335, 2391, 1372, 4835, 1373, 9220, 1590, 3406, 4837,
+	4833, 2868, 4841, 1375, 2678, 1987, 4842, 1376, 9221, 9222, 9223, 9224, 9225, 3926, 9226, 3101,
+	3328, 9227, 1377, 4844, 3995, 1379, 9228, 4845, 4850, 9229, 3869, 4849, 4843, 4846, 4848, 2356,
+	3895, 9231, 4851, 3233, 1683, 2803, 9232, 1382, 4853, 1381, 4852, 1383, 2328, 4854, 4856, 4855,
+	1384, 4858, 4847, 4860, 1385, 9233, 4859, 4857, 1386, 2490, 2869, 3060, 4861, 2942, 1487, 3640,
+	9234, 9235, 3349, 4863, 4862, 4864, 4865, 4866, 4867, 9236, 9237, 3903, 4868, 9238, 1387, 9239,
+	1636, 4869, 9240, 4164, 1708, 4647, 2672, 3133, 4870, 4117, 4062, 9241, 4872, 3173, 3407, 3134,
+	3815, 4873, 4874, 1595, 2564, 4875, 1458, 4876, 9244, 4877, 1570, 9246, 1867, 3542, 3938, 4881,
+	3061, 4880, 2100, 4007, 4879, 3453, 4883, 4882, 9249, 1389, 9250, 4885, 4884, 1596, 4886, 2735,
+	4888, 3201, 4887, 1390, 3873, 2719, 3443, 9252, 9253, 4889, 9254, 2261, 4890, 3573, 3728, 4091,
+	3577, 1391, 4899, 1392, 1904, 4173, 4057, 4994, 4891, 9255, 4894, 1393, 3139, 3972, 3426, 9256,
+	4895, 4017, 2392, 9257, 2736, 1394, 4896, 9258, 2492, 2491, 9259, 1435, 2202, 1395, 2925, 1459,
+	4900, 4901, 4898, 1396, 9260, 9261, 9262, 1528, 1801, 9263, 4897, 4079, 3777, 9264, 1418, 8832,
+	1499, 2493, 1460, 1413, 4906, 9268, 9269, 4904, 1397, 9270, 4070, 4907, 4905, 4903, 2837, 1398,
+	4902, 9271, 3912, 9272, 2230, 4911, 4912, 2737, 4908, 4336, 3616, 4910, 9273, 2357, 4913, 9274,
+	3816, 1399, 4914, 4909, 1400, 1401, 9275, 1402, 4069, 1403, 9276, 3634, 4915, 3778, 9277, 1404,
+	9278, 9279, 9280, 1405, 9281, 9282, 9283, 9284, 4916, 4920, 4917, 9285, 1637, 4919, 1406, 2565,
+	4918, 2157, 4932, 4925, 4922, 9286, 9287, 9288, 3264, 4921, 9289, 4923, 4924, 9291, 9290, 9292,
+	4926, 4927, 9293, 9294, 1868, 4929, 4928, 4930, 9295, 1408, 2804, 1409, 4933, 3350, 9297, 1541,
+	4931, 9296, 4934, 4935, 4938, 4377, 4936, 4937, 9298, 2494, 4939, 9299, 2262, 4940, 4378, 2534,
+	3126, 4941, 4942, 2502, 2263, 4092, 1888, 2203, 4943, 1748, 9300, 4944, 3127, 9301, 4945, 4985,
+	4946, 4947, 4948, 4950, 9302, 4379, 9303, 4951, 9304, 4952, 9305, 3182, 1511, 2611, 1444, 3063,
+	1802, 2559, 2264, 4380, 3454, 9495, 2633, 1803, 3268, 3364, 1434, 1905, 3939, 2572, 1930, 2989,
+	2566, 4144, 4953, 9496, 1940, 2393, 1710, 1571, 2738, 1638, 4954, 4174, 2673, 9498, 2606, 4955,
+	1869, 3528, 4051, 4956, 4957, 3817, 9500, 4959, 1794, 2061, 4958, 9501, 4381, 1804, 2838, 4963,
+	2445, 1639, 4962, 4960, 4964, 4961, 3583, 5591, 2839, 4965, 4382, 4262, 4966, 9504, 4968, 3290,
+	4967, 2917, 2535, 9505, 3150, 2625, 3845, 2990, 9507, 2583, 4969, 2739, 4970, 4971, 1461, 3128,
+	2870, 4972, 3497, 2740, 2741, 4383, 4973, 2991, 2742, 4384, 4974, 4975, 4385, 4106, 4976, 9508,
+	4386, 2012, 9509, 2634, 4977, 4978, 2598, 2832, 3562, 2872, 3754, 3574, 2015, 4979, 1959, 4980,
+	2068, 3525, 1614, 2495, 4981, 4984, 4983, 2067, 4982, 3408, 8833, 3118, 9511, 3427, 2578, 4388,
+	9512, 3064, 4226, 9513, 9514, 9515, 4986, 4987, 3532, 9516, 2463, 4989, 4990, 4392, 9519, 9522,
+	9523, 4991, 4393, 1870, 4992, 4993, 4394, 9527, 1610, 9528, 4395, 9529, 9530, 3035, 1854, 4396,
+	4995, 4050, 9532, 3152, 1749, 9533, 4997, 4999, 1849, 4397, 4996, 4998, 5001, 5000, 5002, 9535,
+	4398, 4399, 4400, 5003, 3507, 1988, 1667, 5004, 5009, 5007, 3941, 3940, 4401, 3455, 5006, 2684,
+	5005, 9538, 2902, 5010, 4404, 2424, 9539, 5016, 5017, 5011, 1711, 5012, 5021, 5020, 5019, 5015,
+	9541, 5014, 9542, 5018, 9543, 9544, 4405, 3942, 9540, 9545, 9546, 9547, 4407, 4408, 4410, 5025,
+	5022, 5024, 4218, 5023, 4409, 5013, 9549, 2903, 9550, 5026, 4411, 2373, 9552, 5027, 5028, 4412,
+	5031, 5030, 5029, 9555, 3456, 5008, 5037, 9557, 9558, 9559, 9560, 5033, 4413, 5032, 4414, 4415,
+	5034, 5035, 9561, 9562, 9563, 5038, 4416, 9565, 4300, 5039, 5036, 5040, 4417, 1850, 5041, 9566,
+	5043, 5042, 5044, 9567, 9568, 5045, 2992, 2635, 9569, 2700, 7810, 3075, 9571, 2265, 2374, 2266,
+	1960, 9572, 5046, 2375, 2204, 5047, 4048, 3607, 5048, 2267, 1798, 3203, 2024, 9574, 2496, 3819,
+	3699, 5049, 1871, 5052, 9576, 7811, 9577, 3291, 7812, 5051, 5050, 5053, 3365, 9579, 2887, 2497,
+	2956, 9580, 3153, 1882, 3292, 5054, 5055, 2805, 3973, 9581, 5058, 5057, 5056, 3853, 5065, 9582,
+	4006, 5059, 5061, 4023, 9584, 5060, 7813, 7814, 5062, 3674, 5063, 3884, 5064, 9586, 9587, 9588,
+	9871, 1805, 3885, 3587, 5066, 5067, 8834, 2268, 1806, 5068, 2186, 4172, 4145, 1872, 5070, 3293,
+	2269, 2743, 3729, 2744, 2720, 3366, 3943, 3409, 2270, 3820, 5071, 9873, 3441, 2384, 9874, 2205,
+	9875, 3367, 1445, 2710, 2271, 4175, 9876, 7815, 9877, 5072, 5073, 3621, 9878, 5074, 4317, 4337,
+	7817, 5076, 5075, 9879, 1731, 5077, 9880, 5080, 5081, 5079, 3792, 2745, 5083, 5082, 5078, 5084,
+	5085, 5086, 5089, 5087, 5088, 5090, 1572, 3368, 5091, 7818, 2158, 1684, 3594, 9882, 5092, 3569,
+	7819, 3913, 7820, 5093, 4338, 7821, 9883, 5094, 3886, 5097, 4419, 4435, 9884, 2554, 3563, 5098,
+	1941, 3294, 1500, 5099, 3860, 2272, 3244, 9885, 7822, 9887, 3369, 7823, 4121, 2187, 2206, 5100,
+	5101, 5107, 2607, 7824, 3295, 7825, 1989, 5102, 3771, 7826, 3234, 7827, 5103, 7828, 9888, 5104,
+	1990, 5105, 5106, 5108, 3470, 7829, 5109, 5110, 7830, 5111, 5096, 5095, 5112, 2101, 9889, 7831,
+	3767, 7832, 2394, 3783, 3296, 3803, 5113, 2746, 1542, 9890, 5114, 5115, 4124, 3730, 7833, 9891,
+	5118, 1597, 2926, 5117, 5116, 2102, 3154, 5122, 5120, 7834, 5119, 4237, 2231, 9892, 2721, 5121,
+	3428, 2662, 3510, 5125, 5124, 7835, 5123, 5126, 2232, 9893, 9894, 7836, 5127, 3852, 2691, 5128,
+	3755, 7837, 3511, 3297, 7838, 7839, 9895, 3400, 5129, 1891, 2840, 9896, 3772, 9898, 7840, 9899,
+	1873, 3578, 9900, 9901, 9902, 5130, 2498, 3974, 3975, 1598, 5135, 7841, 3269, 7842, 9903, 5132,
+	7843, 1685, 9904, 9905, 5183, 5134, 9906, 3588, 5133, 5131, 9907, 2346, 5137, 7844, 5143, 5149,
+	5141, 3444, 7845, 5146, 3821, 9909, 7846, 5140, 5145, 4301, 2499, 3155, 5138, 9910, 9911, 1942,
+	5148, 2927, 1573, 5142, 1686, 5147, 1991, 5144, 7847, 7848, 5150, 5152, 5162, 5160, 5157, 7849,
+	5156, 4318, 9913, 5158, 1992, 2273, 2722, 5165, 5151, 5155, 5139, 1688, 5159, 5161, 3245, 2358,
+	1622, 5153, 5164, 5163, 1993, 3111, 1762, 2103, 5154, 9914, 5166, 5169, 5171, 2567, 9915, 5177,
+	3370, 5167, 5175, 1687, 9916, 5173, 5174, 5170, 5172, 7851, 7852, 2233, 4146, 1807, 1561, 5176,
+	9917, 3598, 1423, 9919, 9920, 3731, 5136, 5182, 5186, 4111, 5179, 3457, 5184, 9921, 2806, 5185,
+	3533, 9922, 7853, 4363, 5181, 7854, 7855, 5187, 9923, 2347, 9924, 2957, 7856, 1462, 5180, 5178,
+	3066, 5168, 2464, 9925, 3140, 5199, 9926, 3067, 5194, 9927, 5191, 5192, 7857, 2608, 5195, 5198,
+	5193, 2637, 5196, 5190, 4134, 4133, 5200, 5201, 1463, 9928, 9929, 9930, 5189, 9931, 2056, 1416,
+	7858, 9932, 1808, 5197, 5205, 5204, 7859, 5209, 7860, 5210, 7861, 9933, 5208, 5211, 5203, 5207,
+	9935, 5212, 5213, 9936, 5202, 2536, 5206, 3156, 2274, 5188, 2841, 5226, 3927, 5216, 5217, 5218,
+	5225, 5224, 9937, 4041, 1809, 5222, 2105, 1712, 5219, 4254, 5221, 1464, 5223, 5214, 5220, 5227,
+	2104, 5215, 9938, 4197, 4147, 5230, 5234, 7863, 3102, 4319, 5235, 9940, 9941, 5232, 5229, 9942,
+	9943, 5228, 5233, 3870, 9944, 3498, 9945, 2106, 5236, 5231, 9946, 5237, 2159, 1615, 7866, 5245,
+	7865, 1810, 5243, 5244, 2359, 5242, 5240, 5239, 5246, 5238, 5248, 1689, 9948, 9949, 9950, 5250,
+	5249, 3298, 5253, 9951, 5251, 5241, 2160, 5252, 5256, 5255, 5257, 5254, 5258, 5259, 5260, 3928,
+	5262, 5261, 2663, 2928, 1668, 1690, 5263, 9953, 1441, 2958, 5264, 7085, 5265, 2133, 5266, 7868,
+	9954, 2993, 9955, 5267, 5268, 1906, 5269, 5270, 5271, 3157, 2207, 4107, 7869, 3976, 2706, 5272,
+	7870, 9956, 2994, 7267, 3732, 2612, 9957, 2395, 5273, 9958, 9959, 3141, 3861, 7871, 3183, 7872,
+	5276, 5274, 5277, 9960, 5275, 9961, 5278, 9963, 3871, 7873, 1433, 9964, 3822, 3733, 9962, 5279,
+	5282, 2747, 1907, 5280, 5287, 2748, 7874, 5281, 3608, 4198, 5283, 5284, 5288, 3458, 5285, 2275,
+	2976, 9965, 5302, 3686, 3184, 9966, 3734, 5371, 3944, 3371, 4033, 5291, 1599, 3270, 5300, 7875,
+	3216, 5294, 5301, 5296, 5303, 5299, 5298, 3651, 5292, 1691, 5290, 1961, 3185, 5286, 9967, 7876,
+	5289, 2276, 2973, 2749, 5297, 3622, 1962, 1732, 1763, 2821, 5305, 5310, 5306, 2161, 5311, 2446,
+	2329, 7877, 9969, 2638, 5293, 2537, 5308, 9970, 2500, 5309, 1446, 7878, 5304, 9971, 9972, 3299,
+	7879, 1963, 1994, 5307, 1417, 2385, 2842, 9975, 9976, 7880, 3372, 9977, 9978, 3718, 5313, 3070,
+	9979, 7881, 9980, 3112, 2455, 5314, 9981, 5316, 5312, 3919, 3319, 9983, 3068, 7882, 3945, 2584,
+	5328, 5327, 9986, 2908, 2162, 5321, 2751, 3550, 3589, 7883, 5319, 3069, 9987, 2626, 5324, 2750,
+	5318, 5323, 3623, 9990, 5317, 2069, 9988, 1753, 5325, 4241, 2396, 3217, 5322, 2974, 2277, 2888,
+	1574, 3036, 5320, 1917, 2107, 3337, 5326, 3071, 9991, 5329, 5331, 3125, 5337, 5332, 5334, 3793,
+	3373, 5335, 9993, 9994, 4148, 4176, 1811, 9995, 9996, 1424, 5333, 7884, 5330, 9997, 9998, 7885,
+	1874, 9999, 1575, 7886, 5336, 9992, 4177, 5340, 10000, 3129, 5347, 7887, 5341, 7888, 5338, 5345,
+	5315, 10001, 7889, 10002, 5342, 5346, 10003, 3700, 3459, 10004, 5339, 5343, 2108, 2431, 2975, 5351,
+	7891, 3390, 10005, 10006, 10007, 7892, 5348, 4012, 7893, 5349, 10008, 5350, 4088, 7890, 2916, 10009,
+	2134, 10010, 5357, 10011, 2465, 5354, 5353, 3590, 10012, 3499, 10013, 3401, 5355, 5356, 3839, 3609,
+	2447, 2995, 3996, 1733, 5363, 5358, 7895, 7896, 4178, 5365, 7897, 5361, 5362, 7898, 10014, 10015,
+	3072, 7894, 7899, 5360, 5295, 10016, 10017, 5364, 5359, 5369, 5370, 3391, 5372, 10019, 10020, 2448,
+	5367, 1908, 5373, 5366, 5377, 5376, 5375, 7900, 5378, 7901, 5380, 2807, 10021, 5379, 10022, 5383,
+	10024, 10025, 10026, 5381, 5382, 5344, 10028, 7902, 5385, 5384, 7903, 5352, 5386, 5374, 2501, 10029,
+	5387, 5388, 5390, 5389, 5391, 1692, 2278, 3946, 2929, 10030, 2208, 5393, 5396, 3810, 1943, 10031,
+	5395, 5394, 3624, 5397, 1995, 5399, 5398, 1812, 2466, 3534, 7905, 10032, 2109, 2904, 5400, 2930,
+	3392, 3823, 5401, 10033, 10034, 5402, 5403, 10035, 10036, 3878, 4949, 2952, 10037, 3804, 2406, 3735,
+	3701, 3429, 4263, 5405, 2586, 7906, 5406, 1432, 2026, 2959, 3824, 5407, 2478, 3235, 2503, 2843,
+	10038, 10039, 5408, 10040, 3947, 1591, 2504, 10041, 5411, 10042, 5409, 5412, 4255, 5410, 10043, 3005,
+	5413, 3120, 5414, 10044, 1876, 5416, 5415, 5417, 5418, 1877, 3570, 3218, 1956, 2505, 3073, 2692,
+	1426, 5419, 10046, 10047, 1600, 5423, 10048, 7908, 2279, 5422, 10049, 2361, 2752, 10050, 7909, 5421,
+	2753, 4074, 2360, 1465, 2960, 7910, 5428, 7911, 2931, 1543, 10051, 7913, 7914, 2685, 4018, 2432,
+	10052, 7915, 2754, 2921, 7916, 7917, 7918, 5427, 5425, 5426, 7912, 3271, 5458, 5432, 2538, 2280,
+	5430, 10053, 7920, 5431, 2844, 7921, 10055, 5429, 10054, 2459, 10058, 7923, 7924, 10059, 5434, 5433,
+	5438, 7925, 5439, 10060, 5435, 7926, 1694, 5436, 5437, 3719, 10063, 10064, 10065, 10066, 3825, 2110,
+	5440, 7927, 2932, 2755, 7928, 7929, 3246, 10067, 10068, 2013, 5441, 5445, 1641, 5442, 5444, 7930,
+	5443, 10069, 2707, 10070, 3236, 1447, 5446, 10071, 5447, 7932, 3300, 4311, 2479, 10073, 3929, 10074,
+	7933, 3977, 10075, 5454, 5449, 7934, 5452, 5451, 10077, 5448, 5453, 7935, 3540, 7936, 5450, 5455,
+	2708, 5456, 7938, 4179, 3660, 5457, 5459, 7939, 5460, 10079, 5461, 2016, 1544, 2281, 5462, 5463,
+	2713, 3074, 7940, 7919, 4662, 3038, 3037, 3158, 2389, 10081, 4500, 10082, 2146, 4149, 3948, 3854,
+	5464, 10083, 7941, 2433, 3321, 5465, 4339, 10085, 3978, 3301, 5466, 1878, 5467, 5468, 4100, 4046,
+	4034, 4008, 2449, 5470, 2613, 7943, 3997, 5472, 5475, 5474, 1875, 1944, 5471, 5473, 5476, 7945,
+	7946, 2909, 10088, 10089, 4227, 1451, 2414, 3130, 2599, 10090, 2809, 5479, 3430, 10091, 5477, 3113,
+	5478, 2808, 4103, 5480, 5481, 4208, 7944, 5486, 2282, 3625, 5483, 3460, 5420, 5424, 10095, 1927,
+	10096, 3611, 7947, 5485, 2756, 3702, 7948, 5491, 3756, 5482, 5488, 5487, 2961, 10098, 7949, 4025,
+	4281, 7950, 4019, 7951, 1642, 2506, 4364, 5490, 2905, 5489, 5484, 2209, 10102, 10103, 5496, 5494,
+	1643, 5492, 5498, 5504, 7952, 7954, 3142, 10104, 3887, 5506, 10105, 3762, 5505, 3652, 3979, 1813,
+	10106, 3001, 2664, 7955, 3341, 10107, 4150, 10108, 5501, 5500, 5502, 5499, 5507, 5497, 5495, 5503,
+	5493, 10109, 3272, 4130, 2576, 2434, 10110, 7953, 2376, 7956, 4027, 1725, 7957, 3336, 3520, 1545,
+	10101, 2996, 2934, 2078, 10114, 7959, 5509, 10115, 2283, 1784, 5511, 1776, 5517, 7960, 10116, 7961,
+	5514, 10118, 2997, 1735, 2362, 10119, 1734, 2397, 10120, 5512, 2137, 2111, 3461, 10121, 10122, 5510,
+	1448, 10123, 10124, 5513, 5515, 2019, 2080, 7958, 1814, 1923, 10125, 10126, 5518, 7962, 2439, 4029,
+	2467, 5519, 3776, 10128, 10129, 7963, 5531, 7964, 1617, 5520, 10130, 10131, 5537, 5521, 4264, 10132,
+	5528, 3635, 5536, 5523, 10133, 1430, 5525, 10134, 2284, 10136, 10137, 10138, 5527, 10139, 5526, 5522,
+	5533, 2757, 7966, 7967, 4871, 2234, 4228, 10140, 10141, 5524, 3374, 1695, 2363, 7968, 5516, 10142,
+	5532, 1757, 5530, 5534, 10143, 3462, 10148, 1880, 10149, 5564, 7969, 4083, 5539, 1879, 10150, 5546,
+	10151, 7970, 3980, 5547, 5549, 10152, 5553, 5541, 10153, 3206, 3463, 5557, 5544, 5554, 10154, 10155,
+	5548, 7971, 10156, 7972, 2845, 5558, 7973, 7974, 2933, 10157, 5556, 1815, 10144, 7975, 4372, 5538,
+	10158, 5552, 1466, 10159, 5540, 7976, 4068, 5545, 2822, 3329, 5535, 5551, 2910, 5561, 1773, 2163,
+	5563, 5542, 5562, 5555, 5543, 5559, 5560, 5577, 3524, 7979, 5550, 5573, 10163, 5575, 3346, 7980,
+	10165, 7977, 4180, 3846, 5570, 3144, 7981, 5576, 3039, 5567, 5580, 5579, 3557, 5578, 3552, 7982,
+	7983, 7984, 10166, 5582, 5569, 10167, 2014, 5572, 2693, 10168, 3636, 5574, 2017, 5566, 5568, 5565,
+	10169, 4340, 1750, 5571, 10170, 7985, 5581, 1713, 2421, 1565, 5598, 10171, 4341, 5601, 10173, 10174,
+	2846, 5600, 5599, 7987, 10175, 5596, 7988, 5584, 10176, 5613, 5583, 5602, 10177, 10178, 5594, 10179,
+	5589, 5586, 10180, 5587, 5595, 7804, 5592, 2285, 3330, 3076, 5590, 7989, 5585, 10181, 5588, 10182,
+	4181, 4022, 5593, 5603, 7992, 10188, 5611, 5604, 7993, 7994, 5617, 5615, 10189, 10190, 5610, 10191,
+	10193, 7995, 7996, 5609, 3338, 3077, 10194, 5607, 5605, 5612, 5618, 3751, 5624, 7997, 10195, 5619,
+	5622, 5616, 7998, 3280, 3784, 10196, 5606, 10197, 5614, 2758, 4089, 5634, 5621, 2164, 1601, 1755,
+	5597, 10185, 10199, 10200, 10201, 2759, 5626, 5633, 2627, 1774, 8000, 3210, 8001, 10202, 5623, 8002,
+	10203, 5628, 5632, 10204, 1996, 10206, 8003, 10207, 8004, 1924, 5630, 8005, 1881, 3521, 5629, 10208,
+	5631, 8006, 5625, 8007, 5627, 10205, 10210, 1756, 3237, 10211, 5638, 8009, 10212, 5636, 2235, 5635,
+	10214, 8010, 5641, 5508, 8011, 8012, 10215, 5637, 10216, 5639, 5640, 8013, 5652, 10218, 5648, 5529,
+	5647, 5646, 5643, 10219, 5620, 5645, 5644, 10220, 10222, 5650, 4330, 10221, 5653, 2065, 10223, 10224,
+	5649, 5651, 8014, 8015, 3673, 5654, 10227, 10228, 10229, 10230, 5658, 5655, 10231, 4219, 5656, 5608,
+	10233, 10234, 10235, 10236, 5659, 5660, 10238, 8018, 1525, 8019, 5662, 2139, 2539, 2027, 1602, 8020,
+	10240, 4199, 8021, 5664, 5663, 5666, 1909, 2028, 1816, 5669, 8022, 5668, 5670, 10241, 1644, 3219,
+	5671, 1817, 5673, 8023, 10242, 5672, 5674, 5675, 8024, 5676, 2507, 2935, 2351, 8025, 3840, 8026,
+	3920, 4360, 10243, 2529, 2398, 4312, 10244, 8027, 5677, 5678, 10245, 2508, 5679, 5680, 10246, 8028,
+	5682, 5681, 4004, 2694, 2614, 2480, 5683, 5685, 2823, 5684, 16075, 5686, 5687, 8029, 5688, 5689,
+	8030, 16076, 5690, 5692, 5691, 5693, 1603, 3238, 5694, 2450, 1736, 5695, 16078, 16079, 3418, 4838,
+	1883, 5696, 16080, 16081, 16082, 5697, 3930, 4020, 8032, 3517, 5698, 3736, 8033, 8034, 3757, 16083,
+	4093, 5699, 16084, 16085, 5701, 5700, 16086, 5703, 16087, 5702, 8035, 16088, 8036, 5705, 16089, 16090,
+	2509, 8037, 4059, 5706, 5707, 1884, 5708, 5710, 5709, 8038, 2889, 16091, 16092, 3785, 1546, 16093,
+	3703, 16095, 3375, 2665, 1945, 16096, 3704, 2552, 5712, 1818, 8039, 1592, 16097, 8040, 3560, 5711,
+	2286, 3247, 5713, 16121, 5721, 5714, 16098, 3135, 1946, 5722, 8042, 8043, 2140, 1885, 5720, 5718,
+	5715, 4200, 16099, 8044, 8045, 3322, 16100, 3535, 5716, 5724, 5723, 2071, 16102, 16103, 1611, 16104,
+	8046, 2377, 5717, 5719, 8047, 4003, 3186, 8041, 4035, 16105, 5732, 5733, 1645, 3862, 4135, 5735,
+	2541, 2760, 5728, 5734, 1576, 1997, 16106, 5725, 5730, 8049, 2998, 3653, 3737, 16107, 5727, 8050,
+	3949, 16109, 5729, 5738, 16110, 5736, 16111, 5731, 8051, 3950, 3612, 1947, 3389, 3273, 16112, 5739,
+	8052, 16113, 16114, 8053, 5737, 3159, 5726, 1547, 8048, 16116, 8055, 16117, 4182, 5750, 8056, 5749,
+	3000, 5746, 4212, 3500, 5740, 16118, 3327, 16119, 16120, 1548, 2287, 5743, 8057, 16122, 8058, 2639,
+	5748, 16143, 5747, 5742, 5745, 8059, 1764, 16123, 5744, 3613, 8060, 4244, 2810, 2999, 8061, 5756,
+	5754, 16124, 3805, 16125, 16126, 5751, 5753, 8062, 1530, 2288, 4342, 1721, 3826, 16127, 4201, 1696,
+	2847, 5755, 16128, 16129, 8064, 5760, 8065, 2761, 8066, 16130, 4152, 16131, 5757, 16132, 5752, 8067,
+	5758, 16133, 4292, 3467, 3512, 8068, 16139, 8069, 16140, 1714, 1557, 16141, 5764, 5767, 4265, 8070,
+	4204, 16142, 8071, 5774, 5768, 5765, 16144, 4282, 5771, 16145, 16146, 16147, 2674, 5773, 5778, 8072,
+	3465, 5776, 8073, 8074, 8075, 5770, 16148, 3220, 5777, 5766, 5772, 16149, 5779, 1502, 5769, 5780,
+	2848, 2695, 3859, 16150, 2364, 5761, 5775, 3410, 8076, 2936, 1765, 2399, 2762, 5763, 2666, 2112,
+	5762, 16135, 5784, 2709, 2188, 5799, 16152, 5793, 1964, 3431, 16153, 5788, 5797, 1425, 1522, 16154,
+	1623, 5790, 3114, 5781, 5783, 2289, 16156, 8078, 16151, 5800, 5795, 16157, 5787, 5794, 8079, 16158,
+	16159, 4053, 16160, 16161, 5792, 5796, 16162, 16163, 16164, 16165, 2210, 16166, 2763, 3221, 8080, 8081,
+	5786, 16167, 4151, 5789, 5782, 3466, 5785, 5791, 4373, 2568, 4042, 5801, 3680, 5813, 2189, 16169,
+	2696, 5803, 4245, 2290, 5816, 1488, 5814, 16170, 5802, 8082, 5808, 8083, 5810, 16171, 4183, 5805,
+	3398, 5807, 16172, 8084, 5815, 16173, 5809, 4081, 8085, 5804, 16174, 2540, 5828, 8086, 1766, 5806,
+	5811, 5812, 16175, 3178, 3160, 16177, 5820, 5826, 5824, 3393, 5831, 5821, 16178, 16179, 5822, 5798,
+	1969, 3786, 2569, 2343, 16180, 16181, 4343, 8087, 5818, 5830, 1577, 3078, 16182, 8088, 3661, 1819,
+	4320, 16183, 8090, 4043, 3340, 8091, 5825, 5827, 8092, 16176, 16184, 3026, 16185, 5829, 5823, 5817,
+	1821, 8093, 16187, 2141, 16188, 5843, 8094, 16189, 5838, 3002, 8095, 1759, 16190, 8096, 8097, 2697,
+	5847, 16191, 5840, 3302, 5837, 3347, 5872, 5834, 5833, 5842, 16193, 8098, 5836, 5835, 5841, 2915,
+	5832, 8099, 8100, 8101, 5844, 16194, 5845, 8102, 16195, 1820, 16192, 16197, 8103, 5850, 5849, 5851,
+	16198, 8104, 5854, 16199, 3419, 5848, 16201, 8105, 5852, 8106, 16196, 2135, 3191, 5846, 3599, 5853,
+	8107, 16202, 16203, 8108, 5858, 5856, 5859, 16204, 5862, 16205, 5855, 2330, 3580, 5759, 16206, 4220,
+	5857, 5861, 3187, 8110, 5860, 5839, 8111, 7405, 8109, 5865, 16208, 5869, 16209, 5867, 8112, 8113,
+	16210, 5863, 16211, 5864, 5868, 5866, 3806, 5874, 5871, 5870, 5873, 3530, 5875, 16212, 3281, 3179,
+	8114, 2919, 5876, 5878, 16214, 16215, 16216, 5877, 16217, 16218, 8115, 16219, 5819, 8118, 5879, 16221,
+	3549, 8116, 8117, 5880, 8119, 16222, 16223, 1646, 16224, 16225, 3468, 1697, 8120, 16226, 1948, 2600,
+	2400, 16227, 16228, 8121, 16229, 4331, 2890, 1578, 5882, 16230, 16231, 5881, 16234, 8122, 8123, 5885,
+	3222, 5888, 5883, 16235, 5887, 8124, 5886, 3416, 1467, 16232, 4315, 16238, 5890, 1512, 16239, 16240,
+	8125, 8126, 5892, 16241, 5891, 5889, 8127, 16237, 5884, 3951, 5894, 16242, 16243, 16244, 16245, 16246,
+	5893, 16247, 8128, 1579, 5896, 3872, 5895, 8130, 8131, 4063, 2765, 8129, 8132, 16249, 8133, 16250,
+	3027, 2764, 8134, 8135, 4321, 16252, 5902, 3003, 16251, 8136, 8138, 16253, 5898, 5903, 1580, 8139,
+	16254, 8140, 5901, 3637, 5897, 5900, 2766, 8141, 3715, 5904, 2587, 3004, 16257, 5907, 8143, 8144,
+	5899, 2075, 5905, 8145, 4184, 5908, 16256, 7808, 16258, 2679, 16259, 16260, 5909, 5910, 16261, 16262,
+	3586, 16263, 5912, 8147, 5913, 8146, 3591, 8148, 3469, 5915, 16265, 5917, 4283, 5914, 16266, 5916,
+	1581, 5911, 16267, 16268, 4759, 5918, 3079, 2468, 5920, 5919, 2824, 4663, 5921, 5923, 5906, 5922,
+	8149, 5924, 8150, 3662, 16269, 5925, 5926, 8151, 5927, 5928, 3352, 16272, 5930, 5929, 5931, 5932,
+	16273, 2601, 3827, 16274, 16275, 4118, 5933, 5934, 3062, 2542, 5935, 5936, 16277, 5937, 3904, 3705,
+	5938, 3627, 3303, 16278, 8152, 8153, 16279, 5939, 1669, 1957, 4080, 4064, 1618, 4344, 16280, 3998,
+	3864, 16282, 16283, 2937, 5940, 16284, 3513, 2165, 5941, 16285, 2402, 5943, 5942, 5944, 8155, 16286,
+	5945, 5946, 8156, 1910, 5947, 5948, 2166, 16288, 16289, 3706, 16290, 16291, 5950, 2811, 5949, 8157,
+	8158, 16292, 1998, 5951, 5953, 5952, 16294, 5954, 2211, 5955, 2047, 3040, 2349, 5957, 5958, 5956,
+	2615, 3518, 1999, 16297, 5960, 3208, 5959, 16298, 8159, 4345, 3638, 16299, 5963, 16300, 5965, 5962,
+	16301, 16302, 4094, 5964, 5966, 4266, 5970, 8161, 8162, 5969, 3282, 3585, 16305, 2167, 5968, 16306,
+	16307, 5967, 4153, 4154, 16304, 16308, 5971, 1582, 2342, 2510, 5972, 5973, 8164, 16309, 5975, 2667,
+	8165, 5977, 5976, 16311, 16312, 16314, 16315, 5978, 16316, 1737, 5980, 16317, 5979, 5982, 5981, 8166,
+	16318, 2190, 16319, 4238, 2018, 16320, 1604, 16321, 8167, 2048, 16322, 16323, 16324, 8168, 8169, 8170,
+	16325, 8171, 16326, 1851, 8172, 4302, 5984, 16327, 16328, 16329, 5986, 16330, 16331, 5987, 1647, 16332,
+	5983, 8173, 2469, 16333, 3323, 5985, 8174, 8175, 8176, 5990, 2616, 16334, 8177, 5988, 16335, 8178,
+	2098, 3707, 5989, 6016, 5995, 16336, 16338, 2191, 16339, 1949, 5992, 4229, 8179, 4246, 8180, 16337,
+	8181, 8182, 3188, 16340, 5994, 8184, 8185, 8186, 8187, 8188, 8189, 8190, 8191, 16341, 5996, 4284,
+	2029, 3758, 3614, 16342, 5997, 5999, 16344, 6002, 16345, 8192, 8193, 8194, 8195, 16346, 16347, 16343,
+	5998, 8196, 6001, 2236, 1549, 6003, 16348, 2900, 6000, 4290, 8197, 6006, 7806, 6004, 6007, 8198,
+	8199, 5993, 6005, 16349, 2378, 6008, 6009, 16350, 4230, 16351, 8200, 8201, 16352, 8202, 6010, 8207,
+	16354, 16355, 8203, 16356, 8204, 6011, 8205, 16357, 16358, 5991, 8206, 16359, 8208, 6012, 8209, 8210,
+	16360, 16361, 1822, 8211, 16362, 16364, 16363, 2543, 8212, 8213, 8214, 6013, 6014, 6015, 16365, 16366,
+	8215, 1531, 16367, 6017, 3787, 6018, 1790, 6019, 6020, 16369, 6021, 16368, 6023, 6024, 6022, 3811,
+	6026, 6025, 16371, 8216, 8217, 6028, 6027, 6029, 6030, 6032, 6031, 2345, 6034, 6033, 8218, 1823,
+	2871, 3412, 6035, 2938, 16373, 2470, 16374, 1593, 6036, 4185, 16375, 3921, 6037, 8219, 3420, 4155,
+	2291, 2849, 3239, 4626, 3304, 1670, 6038, 16378, 16379, 16380, 6039, 6044, 16381, 6042, 6041, 5392,
+	1698, 6040, 16382, 1468, 16383, 3676, 16384, 3708, 4247, 6045, 6043, 3256, 2920, 3677, 3773, 6047,
+	4242, 2113, 6048, 6046, 3720, 6049, 16385, 6050, 8220, 1469, 16387, 16386, 2812, 6055, 6052, 3555,
+	6051, 8221, 16388, 16389, 16390, 1886, 16391, 6058, 6053, 6054, 6057, 6056, 3765, 16393, 3042, 3041,
+	1911, 8222, 6059, 6060, 6061, 16395, 6063, 6062, 1558, 16397, 6071, 3738, 6065, 6067, 16398, 6069,
+	2850, 6070, 6068, 2570, 16399, 16400, 6064, 6066, 3794, 16401, 2767, 6073, 6072, 8224, 16402, 6074,
+	16403, 2544, 2365, 3471, 6075, 3333, 16404, 6077, 16405, 16406, 4231, 6076, 8225, 16407, 3081, 16408,
+	16409, 6082, 16410, 6084, 6085, 3248, 16411, 6083, 6080, 6078, 6079, 8226, 6081, 8227, 16412, 16414,
+	8228, 6088, 6086, 6087, 16415, 16418, 8229, 8230, 16413, 8231, 16419, 16420, 8232, 6089, 6091, 6092,
+	6093, 6094, 16422, 8835, 6090, 16423, 8233, 6096, 16425, 6095, 8234, 6097, 16424, 16428, 4267, 8235,
+	6100, 6098, 6099, 16430, 8236, 1852, 4136, 16431, 3896, 6102, 6101, 6103, 6104, 8237, 8238, 6108,
+	6105, 6106, 6107, 6109, 8239, 16433, 8240, 6110, 8241, 6111, 6112, 6113, 3681, 3432, 6114, 3654,
+	3780, 6115, 8242, 6116, 3394, 1699, 2292, 6117, 6118, 6119, 2453, 6121, 16434, 6120, 6122, 6123,
+	8243, 16435, 8244, 8245, 16437, 16436, 8246, 16438, 16439, 8247, 3739, 16440, 6124, 6125, 8248, 7781,
+	6126, 6127, 6128, 2458, 6129, 3626, 8249, 4011, 1550, 16442, 1559, 8250, 6130, 8251, 6132, 8252,
+	6131, 3464, 2939, 5665, 6133, 4075, 6134, 1824, 3721, 6135, 8253, 6136, 16444, 6137, 16445, 4102,
+	8254, 4095, 3320, 3082, 6139, 8255, 2698, 2768, 6142, 6141, 6140, 3759, 8256, 1825, 2171, 16447,
+	8257, 16448, 6148, 6145, 2851, 4060, 6144, 6146, 6147, 6143, 16449, 8258, 16450, 8259, 6149, 6150,
+	3305, 1853, 3265, 8260, 6151, 8261, 8262, 16455, 6152, 6155, 8263, 8264, 16456, 2891, 8265, 3514,
+	6156, 3999, 6153, 16457, 6154, 16459, 6159, 8266, 16460, 16461, 6158, 6157, 8267, 6161, 6160, 6162,
+	8268, 16464, 6164, 16465, 6163, 16466, 16467, 3899, 16468, 8269, 2686, 4268, 6165, 3501, 6166, 6167,
+	6171, 6169, 6170, 6168, 6172, 6173, 6174, 6175, 4065, 6176, 16469, 16470, 8270, 4122, 6177, 16471,
+	3242, 16472, 3646, 2049, 16473, 16474, 3223, 6178, 2000, 16476, 2962, 16477, 16478, 6179, 2379, 16480,
+	16481, 16482, 6180, 16483, 6181, 2168, 2403, 16484, 6183, 16485, 3438, 2404, 1926, 16486, 8271, 3952,
+	3615, 3439, 2316, 8273, 6185, 16487, 8274, 8275, 8276, 2769, 16488, 8277, 16489, 4248, 2293, 8278,
+	2169, 3669, 6187, 6189, 2237, 6188, 3376, 16491, 6191, 1715, 6186, 3740, 1520, 16492, 2426, 4374,
+	6190, 16494, 16495, 16496, 6192, 16493, 3897, 2971, 6194, 8282, 6195, 8280, 6193, 1738, 16497, 6201,
+	6200, 2545, 6202, 6197, 16498, 6203, 6198, 16499, 16500, 3722, 6196, 6199, 16501, 6206, 16502, 16503,
+	8283, 16504, 6205, 4013, 6204, 1485, 8284, 6208, 8286, 16505, 16506, 16507, 6207, 16508, 8285, 2770,
+	6209, 3043, 16510, 6211, 6210, 6212, 16511, 8287, 16513, 6182, 6184, 6214, 6213, 8288, 16512, 8289,
+	8290, 2546, 16514, 4303, 2588, 6215, 2091, 8293, 8294, 1912, 1887, 2511, 16515, 4156, 6221, 6220,
+	3044, 6217, 16516, 6219, 16517, 8298, 2675, 2852, 6218, 6216, 3582, 2771, 16519, 3788, 2405, 16520,
+	3472, 8302, 6222, 6223, 6245, 2030, 4353, 3029, 6224, 1648, 3377, 3855, 16521, 16522, 16523, 8306,
+	16524, 6225, 16525, 1970, 6226, 16526, 6228, 6229, 3581, 8307, 6230, 16527, 8308, 6231, 6232, 16528,
+	2031, 1649, 3515, 2640, 2512, 16529, 16530, 8309, 6233, 8310, 2641, 16531, 16532, 1640, 3795, 8312,
+	6234, 16533, 3741, 8313, 3045, 6237, 6238, 3645, 2853, 6235, 3261, 8314, 6236, 8315, 2772, 1470,
+	1889, 8316, 6239, 16538, 3378, 6240, 2953, 16539, 4055, 16540, 3763, 6241, 6242, 3249, 16541, 4269,
+	16542, 6244, 6243, 16544, 2617, 6246, 1490, 6249, 16545, 8318, 6247, 1650, 2114, 6248, 2294, 2338,
+	3924, 6250, 4000, 16546, 6252, 16547, 2963, 1551, 1624, 1422, 16548, 6251, 16549, 16550, 8319, 16551,
+	16552, 6253, 6254, 2813, 16553, 6255, 16554, 1739, 8320, 6257, 2142, 16555, 1950, 16556, 6258, 2059,
+	6259, 3006, 3523, 16557, 2978, 2435, 8322, 6260, 16558, 16559, 3262, 3083, 6262, 6264, 6261, 6263,
+	2070, 8323, 16560, 6265, 2074, 16561, 1951, 4186, 6267, 8325, 6268, 16564, 1518, 8326, 16565, 16566,
+	6271, 1778, 6270, 6269, 6273, 6266, 6274, 4239, 16568, 6275, 8327, 6276, 16569, 4878, 6278, 6277,
+	6279, 6280, 4251, 6281, 7505, 2773, 6282, 6283, 2687, 3502, 6284, 16570, 16571, 3204, 8328, 6285,
+	3224, 6286, 16572, 1979, 4543, 3257, 2557, 8329, 1826, 6287, 6300, 6290, 16575, 1952, 6289, 6302,
+	6288, 2774, 8330, 6292, 6293, 3395, 6294, 1754, 2878, 3828, 16578, 6296, 16579, 3174, 8331, 16580,
+	16581, 16582, 6291, 6295, 6297, 2441, 8332, 16577, 16586, 6304, 3774, 8333, 3672, 3473, 2032, 6303,
+	6301, 8334, 3687, 6298, 3258, 3475, 3474, 16588, 2436, 6322, 8335, 16590, 6306, 16591, 6308, 16592,
+	6311, 8336, 6312, 8337, 6309, 6310, 8338, 16593, 6307, 6305, 16589, 6299, 16585, 3901, 1651, 6319,
+	6316, 6321, 16596, 16597, 6320, 3655, 4049, 2471, 6314, 6323, 6318, 16598, 6317, 6313, 8339, 6315,
+	1827, 3225, 3007, 16600, 3668, 6328, 16601, 3670, 16602, 2979, 6325, 3713, 16603, 6329, 3905, 3255,
+	16604, 6324, 6326, 6327, 16607, 16608, 8341, 8342, 16609, 6330, 2574, 3516, 6335, 6334, 6331, 16610,
+	4346, 16611, 6340, 6344, 6341, 6337, 8343, 16612, 6338, 16613, 6343, 16614, 16615, 6332, 4659, 6339,
+	6333, 6342, 8345, 6348, 8346, 1828, 6345, 16616, 6346, 6347, 6350, 8344, 8347, 8348, 6349, 3752,
+	6351, 4322, 3931, 6356, 6353, 16617, 16618, 6352, 2964, 6355, 6357, 16619, 6354, 6361, 16620, 6358,
+	8351, 16621, 6359, 6336, 16622, 6360, 6362, 16623, 6363, 8352, 16624, 16625, 16626, 3892, 6364, 8353,
+	4108, 2020, 2077, 6365, 3874, 2892, 4058, 8354, 6366, 4249, 8355, 3656, 3046, 3592, 2677, 1442,
+	8356, 6371, 6369, 6367, 1787, 16630, 2775, 6372, 6370, 6368, 6376, 6375, 6374, 6373, 16632, 16633,
+	6377, 8357, 6378, 2940, 16634, 6379, 6381, 16636, 6380, 16638, 2212, 16639, 3032, 6383, 8358, 3476,
+	16641, 6382, 8359, 6384, 8360, 3875, 3084, 2295, 6385, 16643, 4270, 16644, 6387, 6388, 6389, 16646,
+	6390, 2513, 6391, 2115, 1954, 1890, 6393, 16647, 4125, 2296, 6392, 8361, 8362, 16648, 6396, 4112,
+	3600, 3779, 16650, 8363, 2699, 6395, 2589, 2297, 2514, 1953, 3876, 6394, 8364, 16651, 3047, 3982,
+	2437, 8365, 2515, 3351, 6399, 4293, 2407, 8366, 6400, 2854, 6402, 2776, 2366, 16654, 16655, 6401,
+	16656, 8367, 2642, 2192, 3048, 6397, 6403, 16657, 8368, 6398, 2116, 6406, 6410, 2143, 8369, 6405,
+	16661, 16662, 6414, 8370, 2298, 4213, 1438, 6411, 1955, 6408, 16663, 6409, 16664, 3477, 6407, 6404,
+	1700, 2982, 2170, 8371, 6416, 16666, 8372, 16668, 16669, 6413, 8373, 16670, 6415, 6412, 16671, 2117,
+	3121, 6417, 3086, 16673, 6430, 8374, 6426, 6420, 8375, 16674, 16675, 6424, 2628, 1471, 6419, 6427,
+	6431, 2299, 4096, 3344, 6421, 16676, 16677, 6429, 6418, 3226, 6423, 1439, 4084, 16678, 8376, 6422,
+	2033, 3742, 8377, 3085, 4278, 2711, 6470, 8378, 16679, 6432, 16680, 3008, 6428, 6433, 6435, 3379,
+	6438, 8379, 6434, 16681, 3906, 1829, 4085, 1472, 16682, 6437, 4323, 16683, 6436, 1583, 3554, 6439,
+	8382, 6446, 6440, 6447, 16687, 8383, 6443, 8384, 16688, 3663, 16689, 2580, 6445, 16690, 6442, 6448,
+	6441, 2668, 16691, 8381, 3953, 16692, 2676, 6444, 6456, 6452, 6455, 6453, 6457, 6451, 6425, 2965,
+	3709, 6454, 16693, 6449, 8386, 3009, 2118, 2643, 8387, 2825, 3030, 6460, 6462, 6463, 6459, 6461,
+	8388, 6450, 6458, 6466, 6465, 8836, 4038, 2079, 16694, 16695, 6464, 6468, 6467, 6471, 16696, 6473,
+	2472, 6469, 16697, 16698, 6474, 6475, 8389, 6476, 8390, 6482, 3411, 6478, 16700, 6477, 6479, 6480,
+	6481, 16701, 16702, 6483, 6484, 1830, 6485, 6486, 16705, 16704, 16706, 16707, 16708, 6487, 8391, 6488,
+	6489, 6490, 16709, 6491, 6492, 16712, 6494, 6493, 6495, 6496, 6497, 16714, 16715, 16716, 6500, 6498,
+	6499, 2415, 2119, 16717, 3250, 3685, 2712, 3617, 3743, 6501, 5247, 16718, 16719, 6502, 6504, 4205,
+	6503, 6506, 6505, 4187, 6507, 3760, 16721, 8393, 6508, 16724, 8394, 6511, 16725, 6510, 6509, 6512,
+	2086, 3010, 1913, 16726, 6516, 6513, 6514, 6517, 6518, 6515, 1513, 8395, 1605, 16728, 6520, 6521,
+	6522, 4202, 8396, 16729, 2644, 6524, 6523, 8397, 8398, 2893, 6525, 16730, 16731, 6526, 6527, 1855,
+	8399, 8400, 16732, 1831, 16733, 6528, 6529, 8401, 4009, 4203, 4188, 4347, 16734, 2301, 6532, 2590,
+	6531, 16736, 16735, 6533, 2547, 16737, 3151, 16738, 6534, 8403, 2300, 16739, 4097, 6535, 6536, 6537,
+	6538, 16740, 8404, 6539, 16741, 16742, 2548, 8405, 4119, 8406, 6541, 8407, 3227, 6540, 16743, 6543,
+	6542, 6544, 2941, 6545, 6546, 3879, 6547, 3087, 6548, 16744, 6549, 4324, 6552, 16745, 6551, 6550,
+	3306, 16746, 6553, 2826, 6554, 16747, 6555, 4348, 6556, 6557, 6559, 6558, 3671, 3567, 4354, 3675,
+	6561, 2777, 3770, 16748, 6562, 6560, 16749, 1832, 2214, 2516, 16750, 3744, 16751, 2172, 3983, 6565,
+	6563, 2302, 2303, 1483, 2422, 16752, 3629, 1473, 6570, 16754, 3228, 8408, 3628, 3161, 16755, 6572,
+	8409, 16756, 6568, 6571, 6566, 6569, 3954, 8410, 2215, 1503, 6567, 16757, 6574, 6575, 8411, 3503,
+	16760, 2001, 16761, 6586, 3601, 2517, 2002, 2954, 4362, 4056, 6573, 2966, 16759, 16764, 8412, 1931,
+	6576, 16765, 6578, 16767, 16768, 6577, 16769, 6579, 3202, 3602, 16763, 3307, 16771, 6583, 6582, 8413,
+	6580, 2873, 3829, 6585, 6584, 2304, 4375, 6601, 8414, 6589, 6590, 8415, 8416, 16773, 2618, 8417,
+	6588, 2344, 6587, 6591, 3308, 3856, 3011, 3162, 6595, 16775, 6596, 6592, 16777, 16778, 6593, 6594,
+	2305, 6602, 6598, 16780, 3830, 4024, 3768, 6597, 6600, 6599, 3984, 6603, 6604, 16781, 3031, 6605,
+	6607, 6611, 8418, 6608, 6606, 3603, 6609, 16782, 6610, 16783, 1616, 6617, 6612, 8419, 6613, 8420,
+	6614, 3103, 16784, 8421, 6616, 6615, 6618, 16785, 6619, 6620, 2855, 16787, 1671, 6621, 4285, 2549,
+	16789, 2645, 2518, 3251, 6622, 6623, 1521, 16791, 6624, 16792, 6625, 6626, 16794, 6627, 6628, 2003,
+	5368, 6629, 2983, 6630, 2581, 6631, 4434, 6632, 3916, 1846, 16796, 3012, 2688, 3841, 2646, 16797,
+	16798, 6633, 2306, 6634, 3710, 6648, 16800, 6636, 16801, 3143, 3657, 2193, 6635, 3013, 16802, 6637,
+	16805, 16803, 3380, 16804, 16807, 16809, 16811, 6639, 6638, 6641, 16813, 6640, 6642, 8425, 6644, 16814,
+	6643, 1833, 6645, 6646, 6647, 2367, 4271, 6649, 2827, 8426, 1584, 6650, 6651, 16818, 16820, 6652,
+	16821, 16822, 16823, 1492, 6653, 8428, 6654, 16824, 3831, 2577, 6656, 8429, 8430, 8431, 1701, 1428,
+	16825, 16826, 8432, 6655, 6658, 3618, 8433, 2856, 1652, 16827, 3955, 16828, 8434, 2129, 2034, 16829,
+	6657, 16830, 1672, 8435, 8436, 1789, 8437, 1585, 6662, 4304, 3163, 8438, 3796, 6674, 1653, 6672,
+	6670, 6661, 6659, 16831, 6660, 2605, 2050, 3283, 16832, 3527, 1553, 6664, 6663, 16833, 6669, 6665,
+	6668, 8439, 8440, 8441, 8442, 4090, 6667, 1654, 1785, 6671, 16834, 16835, 6673, 16836, 2120, 6677,
+	6686, 6687, 16838, 1421, 16839, 8444, 6694, 1491, 6685, 8445, 6684, 16840, 6679, 6678, 6676, 6675,
+	3263, 3193, 6681, 8446, 16841, 6680, 8447, 16842, 6683, 8448, 3088, 2121, 1537, 6682, 8449, 2307,
+	16837, 8450, 16843, 3089, 8443, 10100, 6700, 6701, 1655, 1612, 6698, 8451, 16845, 6688, 8452, 6696,
+	6703, 6697, 16848, 6695, 8453, 6666, 16849, 16850, 6693, 8454, 6689, 16851, 16852, 1834, 6691, 6702,
+	6692, 8455, 6704, 8456, 6690, 3664, 4209, 6699, 6721, 8457, 8458, 6713, 2911, 8459, 16853, 1918,
+	2035, 6708, 8460, 16854, 8461, 1657, 16855, 2778, 6711, 2408, 16856, 3433, 6716, 8462, 16857, 3932,
+	8463, 6707, 1656, 2216, 3769, 6717, 6705, 6714, 16858, 6724, 6709, 8464, 6710, 3504, 8465, 6715,
+	8466, 6712, 3956, 6718, 1474, 16859, 16860, 6706, 16861, 16863, 6720, 6719, 3647, 6726, 6737, 1786,
+	6740, 6722, 16864, 6727, 4214, 6736, 8468, 4189, 16865, 4240, 16866, 16867, 16868, 3284, 16869, 16870,
+	1767, 16871, 8469, 3842, 6742, 3479, 16873, 1427, 6735, 6731, 3090, 6725, 6733, 6738, 8470, 3584,
+	8471, 1420, 16874, 6730, 16875, 6739, 3848, 16876, 16877, 6734, 6729, 8472, 2779, 2647, 2550, 16880,
+	4098, 3799, 8473, 6745, 6754, 16881, 6732, 8474, 1779, 8475, 2814, 6743, 8476, 6748, 3091, 16882,
+	6744, 8477, 6751, 8478, 3259, 6752, 4190, 6741, 1716, 6747, 16884, 16885, 6750, 4054, 16886, 6753,
+	6746, 6749, 16879, 16888, 16889, 3957, 4325, 16890, 16891, 16892, 6757, 16893, 6764, 16894, 6756, 2573,
+	6723, 16895, 3900, 4044, 6763, 6762, 6758, 6759, 1526, 16896, 8481, 6761, 6755, 8482, 16897, 16898,
+	3343, 16899, 6760, 1504, 8483, 8480, 16900, 3104, 3889, 16905, 6765, 6769, 3723, 6768, 2780, 2579,
+	6771, 2004, 16901, 16906, 16907, 6772, 3849, 6767, 8484, 6728, 16908, 16909, 16910, 6766, 8485, 4371,
+	3480, 3843, 6779, 16902, 6785, 8488, 16912, 16913, 6786, 16914, 6773, 16915, 3658, 16916, 6783, 6775,
+	6777, 8489, 8490, 6787, 6776, 16917, 6780, 1586, 3547, 6781, 6784, 16918, 16919, 8491, 6774, 3014,
+	6778, 2451, 2857, 2084, 4126, 8487, 4131, 2714, 8492, 16923, 6791, 6789, 16924, 4370, 16925, 6788,
+	16926, 8493, 4221, 8494, 6790, 6792, 6793, 16927, 16928, 6796, 6794, 16929, 16930, 3481, 6795, 16931,
+	3711, 6782, 8495, 16932, 2715, 6797, 6802, 3092, 6801, 8497, 16933, 6770, 8498, 8499, 6803, 3049,
+	6798, 6800, 8500, 16934, 8496, 6799, 5657, 5642, 8502, 6805, 16935, 16936, 6804, 16937, 16938, 8503,
+	4222, 6138, 6806, 8504, 16940, 16941, 6807, 16943, 1388, 6808, 2217, 1932, 16945, 6810, 4573, 16946,
+	8505, 1965, 8506, 4256, 2057, 6811, 8508, 6812, 3274, 8510, 16947, 6813, 8511, 16948, 3568, 16949,
+	1436, 16950, 16951, 1665, 6818, 6819, 16952, 16953, 6814, 2473, 8512, 16954, 16955, 6815, 3606, 16956,
+	6816, 6817, 6827, 6821, 6824, 16958, 16959, 6820, 8513, 16960, 16961, 6822, 6823, 2594, 6825, 3229,
+	2122, 1726, 16962, 6828, 16963, 16964, 1723, 6833, 6829, 6832, 16965, 3692, 16966, 6830, 6831, 3800,
+	3724, 6834, 3195, 6844, 8514, 6840, 8515, 8516, 1673, 6838, 3958, 6839, 16968, 6836, 16969, 6837,
+	6842, 6845, 8517, 6843, 16970, 16971, 6841, 6835, 16972, 3252, 16973, 6852, 4052, 16974, 16975, 16976,
+	6850, 6851, 16977, 6846, 6848, 16978, 6849, 16979, 6847, 16980, 16981, 2984, 4349, 6856, 6857, 16982,
+	6863, 2830, 6859, 16983, 6862, 6854, 6853, 6864, 16984, 16985, 1658, 6860, 6865, 6861, 8518, 16986,
+	6858, 3309, 6855, 16987, 3644, 6869, 8519, 8520, 16991, 16992, 4163, 16993, 6868, 16994, 6867, 16990,
+	6876, 8521, 8522, 6870, 6878, 8523, 4206, 6881, 6872, 16997, 6873, 16999, 6877, 6880, 6879, 6871,
+	17000, 6874, 6891, 8525, 17002, 6884, 17004, 17005, 17006, 17007, 17008, 8526, 17009, 6882, 6883, 17001,
+	6888, 6889, 1702, 1914, 6887, 17010, 17011, 6866, 8527, 8528, 17012, 6886, 6890, 6885, 8529, 6892,
+	17013, 8530, 6894, 6893, 8531, 6896, 6895, 6826, 6900, 17014, 17015, 6897, 17016, 6898, 6899, 6901,
+	17017, 2144, 6903, 6902, 2648, 17019, 2308, 5741, 6904, 2681, 1717, 17020, 6905, 1554, 2781, 6906,
+	17021, 2309, 6907, 1475, 17022, 3789, 17025, 6908, 17027, 2894, 6915, 6912, 3275, 6913, 6910, 2036,
+	6909, 6916, 2090, 3164, 6922, 6918, 3123, 6917, 8532, 6920, 6911, 17028, 6921, 6923, 8533, 3745,
+	6919, 6924, 6926, 2213, 6914, 1443, 17029, 17030, 17031, 6925, 17032, 2409, 4316, 6927, 6928, 3093,
+	8534, 8535, 4232, 17035, 17033, 17036, 6929, 4157, 6930, 6931, 17037, 3922, 6932, 2382, 4233, 6937,
+	17038, 17039, 6938, 2782, 6936, 8537, 4207, 6933, 6935, 2943, 2914, 17041, 6934, 6939, 3857, 6941,
+	6940, 1768, 3959, 6942, 8540, 8541, 8542, 8538, 6954, 6944, 6945, 8543, 6946, 6947, 8544, 8545,
+	6951, 17043, 6952, 8546, 6950, 8547, 6948, 17045, 6943, 6949, 17046, 17047, 6953, 7449, 1606, 6956,
+	2037, 6955, 17050, 6958, 6957, 6960, 6959, 6961, 2649, 6962, 6963, 6964, 2944, 17051, 4191, 6965,
+	3858, 3610, 6966, 17052, 6967, 2173, 1892, 17053, 6968, 8548, 2519, 3605, 6969, 1740, 17054, 6970,
+	6972, 4223, 6971, 2858, 6973, 6974, 17055, 6975, 1835, 17056, 6976, 6977, 6978, 6979, 1741, 8550,
+	17057, 17058, 6980, 6981, 6982, 1680, 8551, 2828, 6983, 17059, 17061, 17062, 6984, 8552, 6985, 17063,
+	17064, 2194, 3381, 6986, 2123, 2874, 6989, 3482, 6988, 17066, 8553, 2085, 17067, 8554, 6987, 3189,
+	1893, 6990, 6991, 2783, 17069, 8555, 2145, 6992, 3960, 2977, 1966, 4127, 3050, 17070, 6993, 8556,
+	2859, 3276, 2784, 17071, 6994, 17072, 6997, 8557, 6998, 17073, 8558, 2380, 3136, 6996, 2785, 3790,
+	17074, 6995, 8559, 2520, 1555, 8560, 7002, 2124, 2522, 2521, 4369, 7001, 7000, 3015, 1925, 4359,
+	1718, 2786, 8561, 8562, 6999, 7004, 7005, 7003, 2218, 4168, 2523, 3579, 17076, 7008, 2946, 3230,
+	4158, 7011, 2238, 2945, 7007, 7012, 2239, 7009, 7010, 8563, 7006, 2980, 3519, 17075, 3211, 1659,
+	17077, 3746, 1915, 17078, 3310, 7015, 7013, 3240, 2947, 1836, 7014, 2879, 8564, 4272, 4356, 17079,
+	7016, 7027, 3311, 7026, 8565, 7023, 7031, 7024, 7020, 3382, 7019, 7017, 4137, 2524, 7021, 7018,
+	8566, 8567, 7025, 2716, 2195, 17080, 3192, 3985, 1562, 1476, 3483, 17083, 7029, 17082, 7028, 17084,
+	3548, 7033, 7022, 7032, 7034, 2174, 7030, 2310, 17085, 2591, 17086, 7035, 4192, 7038, 7041, 7039,
+	3781, 17087, 7036, 2038, 7040, 7042, 17088, 8571, 7046, 7043, 7045, 7044, 17090, 8572, 7047, 2555,
+	8573, 7049, 7048, 3832, 17091, 7051, 2125, 8574, 7050, 7052, 7053, 1916, 6519, 2815, 7054, 17092,
+	2240, 7055, 17093, 7056, 17094, 2474, 5404, 17095, 7057, 7058, 2650, 7059, 7060, 17096, 17097, 7061,
+	7062, 7063, 17098, 8575, 17099, 3207, 17100, 7064, 17102, 7066, 7065, 17105, 3484, 17106, 7067, 8576,
+	3961, 7068, 7069, 17107, 7070, 17108, 7071, 17109, 3536, 2787, 7072, 8577, 17110, 2331, 4432, 7073,
+	17111, 17112, 7074, 3791, 7075, 7083, 7076, 7078, 7077, 7079, 3986, 7080, 7081, 17115, 17114, 7082,
+	7084, 17116, 17118, 1705, 3361, 3833, 2416, 2311, 17119, 3807, 1661, 3712, 7087, 1837, 2967, 7086,
+	7091, 3285, 4109, 7089, 7090, 1894, 7092, 3639, 3165, 3747, 3413, 7088, 3987, 1674, 7094, 4332,
+	3324, 4361, 2525, 7093, 3119, 7110, 3016, 3566, 3808, 17121, 17120, 17122, 7097, 2475, 2526, 2788,
+	3641, 8579, 2175, 7096, 7095, 3834, 2571, 3434, 17124, 17125, 8580, 8581, 7099, 7100, 2312, 7098,
+	8582, 7101, 7102, 7104, 3105, 17127, 7103, 1856, 7106, 17128, 7105, 7107, 8584, 7109, 7111, 7112,
+	8585, 2968, 2585, 7113, 1742, 17129, 7114, 3094, 7115, 7116, 3835, 1895, 7117, 17130, 3312, 1563,
+	17131, 7118, 2619, 17132, 2906, 8586, 3115, 7121, 7120, 7119, 17134, 17135, 7127, 7125, 8587, 7122,
+	8588, 7124, 8589, 17136, 7123, 7126, 1967, 7130, 2969, 7131, 2219, 7128, 7129, 17137, 4333, 3313,
+	3017, 7132, 17140, 7135, 17141, 7133, 7134, 4193, 17138, 3485, 7138, 17143, 17144, 7136, 7137, 7139,
+	8590, 17145, 17146, 8591, 17147, 7153, 7142, 7143, 7141, 17150, 17151, 8592, 7140, 3383, 7145, 7149,
+	7146, 7144, 17152, 7147, 7148, 17153, 7155, 7150, 2970, 7152, 17154, 7154, 7151, 17155, 17156, 17157,
+	8593, 7157, 2651, 7156, 8594, 7158, 7159, 7162, 7161, 7160, 7164, 7163, 4128, 7166, 7165, 7167,
+	17158, 7168, 17159, 7170, 7169, 2860, 7171, 17160, 2051, 7172, 7174, 17161, 17162, 17163, 17164, 7175,
+	8596, 7176, 7173, 7177, 2592, 7178, 1896, 2087, 17166, 2176, 17167, 7179, 3558, 3414, 7180, 7183,
+	2558, 17169, 8598, 7182, 7181, 2126, 7184, 17171, 1743, 7186, 17172, 2410, 7185, 7194, 7188, 7190,
+	3923, 7187, 17173, 7189, 7193, 7191, 1897, 8599, 7192, 7195, 3630, 4286, 17174, 8600, 2652, 7196,
+	8601, 4138, 7198, 7197, 7201, 4169, 17175, 7200, 1769, 7199, 7204, 7203, 17176, 7202, 3402, 7205,
+	8602, 7206, 17177, 7207, 2332, 2073, 7208, 7209, 7210, 2861, 7211, 2551, 7212, 7213, 17179, 8604,
+	4607, 4606, 7214, 6472, 7215, 3200, 2831, 3604, 17180, 8605, 17182, 7216, 3907, 3342, 2350, 3205,
+	17184, 1514, 4036, 2875, 17185, 17187, 17188, 2130, 2039, 17189, 3908, 17190, 7217, 17191, 7219, 8606,
+	7218, 1662, 8607, 3564, 7220, 3659, 3403, 8608, 7221, 2682, 17192, 7223, 17193, 4076, 7238, 7225,
+	7226, 17194, 3331, 3166, 3095, 17195, 3486, 7224, 1933, 8609, 7233, 17196, 7230, 7243, 3487, 3260,
+	7227, 3384, 3435, 7228, 7232, 2884, 3643, 3334, 2948, 7231, 3116, 3106, 7229, 1419, 4326, 7234,
+	8610, 3167, 2653, 2862, 7236, 7235, 17197, 1489, 7237, 3775, 7245, 3537, 2895, 17199, 17200, 3253,
+	2062, 7244, 4159, 1535, 3909, 1663, 7239, 7240, 7241, 7242, 3505, 3199, 1477, 7246, 7247, 7805,
+	3131, 17201, 7248, 1587, 3052, 2177, 4194, 7249, 3396, 3096, 2593, 7250, 7253, 2701, 7251, 3019,
+	3018, 1478, 4273, 7255, 3748, 7257, 7256, 7254, 6272, 1838, 17204, 7222, 8612, 7259, 7258, 17205,
+	7260, 17206, 4160, 8613, 8614, 8615, 8616, 17208, 8617, 3543, 3962, 7261, 2595, 7262, 17210, 7263,
+	8618, 7264, 17211, 3385, 8619, 1484, 17212, 8620, 8621, 8622, 17213, 2313, 4350, 8623, 7268, 17215,
+	8624, 8625, 17216, 2088, 7265, 7266, 3844, 17221, 1744, 8626, 17219, 8627, 4161, 17218, 2005, 3436,
+	17222, 17223, 17220, 7269, 17224, 17225, 7270, 8629, 8630, 8631, 17226, 7271, 17227, 17228, 8632, 8633,
+	8634, 3386, 8635, 7273, 8636, 7272, 8637, 17229, 17230, 17231, 8638, 17232, 17233, 8639, 3529, 7274,
+	2654, 2602, 3631, 3277, 2620, 2896, 7275, 17234, 7276, 17235, 8640, 17236, 2881, 7277, 17237, 7278,
+	7279, 4215, 2655, 7281, 7280, 17238, 2314, 2339, 2476, 17239, 7284, 8641, 17240, 2702, 7283, 7282,
+	3175, 17241, 2241, 2949, 3682, 2657, 8642, 7285, 2789, 17242, 7288, 7286, 8643, 17243, 7287, 8644,
+	17244, 7290, 7289, 17245, 2816, 7291, 8645, 7292, 7293, 17246, 17247, 3714, 2401, 2603, 7294, 7295,
+	4234, 2669, 4120, 4274, 7296, 2040, 7297, 8646, 3387, 17248, 7300, 1780, 2863, 7298, 7299, 3354,
+	8648, 8649, 4001, 2066, 17251, 8650, 17252, 8651, 7302, 7303, 8647, 7301, 7305, 17253, 8652, 17254,
+	3541, 1727, 8653, 7309, 7306, 7308, 17255, 17256, 7304, 7379, 7307, 17258, 4305, 2220, 8654, 8655,
+	8656, 8657, 7317, 8658, 17259, 8659, 3404, 7312, 17260, 7315, 7313, 17261, 7318, 17262, 8660, 7319,
+	17263, 17264, 7311, 17265, 7324, 1588, 7310, 17266, 17267, 3679, 7314, 17268, 2790, 17269, 17270, 2315,
+	8661, 17273, 17274, 3988, 17275, 2042, 2670, 3506, 8662, 17276, 17277, 3021, 7322, 7316, 7321, 17278,
+	4077, 17279, 3314, 7323, 7320, 17280, 17281, 17282, 3020, 17285, 7327, 7326, 17286, 17287, 8663, 17288,
+	8664, 8665, 17289, 7325, 17290, 3963, 8666, 17291, 17292, 8667, 2723, 17293, 17294, 7328, 3917, 1556,
+	3798, 3278, 17298, 1968, 17299, 7330, 17300, 2317, 8668, 17301, 8669, 2456, 17302, 7329, 2897, 17295,
+	17303, 2898, 7333, 7335, 17304, 17305, 8670, 2817, 8671, 7334, 7336, 8672, 2025, 17306, 3797, 17307,
+	2604, 4327, 7332, 2438, 4355, 7338, 7337, 7339, 7331, 17310, 8673, 8674, 8675, 3551, 3437, 17311,
+	3345, 7344, 17312, 17313, 3231, 7340, 7341, 8676, 8677, 8678, 17314, 2081, 7343, 8679, 17315, 17316,
+	2178, 7342, 2791, 17320, 17321, 1781, 17322, 7348, 2381, 3097, 3332, 8680, 17323, 17317, 8681, 1719,
+	7346, 7347, 3325, 7345, 7349, 8682, 17328, 7355, 8683, 17329, 7358, 7357, 3397, 7350, 7351, 7354,
+	17330, 7356, 8684, 8685, 2006, 17331, 7359, 7353, 17332, 7352, 17327, 17324, 17337, 8687, 7363, 8686,
+	7364, 17333, 17334, 7365, 7362, 7361, 17335, 17336, 2792, 3488, 7360, 7369, 7367, 17338, 8688, 17339,
+	7368, 7366, 3190, 7370, 17340, 7371, 7373, 17341, 8689, 1839, 7372, 4132, 7384, 7374, 7377, 7375,
+	7376, 8690, 7378, 8691, 17344, 17345, 7380, 8692, 8693, 7381, 7382, 7385, 7383, 7386, 7388, 7387,
+	3315, 17346, 17347, 4113, 7389, 3022, 17348, 7390, 3890, 7391, 1703, 17349, 17350, 8694, 1532, 1841,
+	1840, 7392, 7393, 7394, 7395, 8695, 7396, 1842, 1745, 2318, 3688, 8696, 7398, 7397, 8697, 17352,
+	8698, 7399, 1564, 17353, 8699, 7402, 7401, 7400, 8700, 7403, 7406, 1449, 17355, 7404, 8701, 7408,
+	7407, 8702, 8703, 7410, 7409, 7411, 3492, 8705, 7412, 8706, 7413, 7415, 7414, 3836, 17207, 7416,
+	7417, 2419, 17358, 7418, 7419, 3989, 17359, 17360, 3051, 17362, 1414, 3145, 17363, 7420, 3837, 7423,
+	7421, 2319, 7422, 2196, 8707, 3891, 7425, 7427, 7426, 7428, 17364, 1505, 2876, 2724, 1843, 7429,
+	3642, 7431, 1506, 7430, 3326, 4275, 3489, 7424, 4236, 2179, 4195, 17366, 8708, 2063, 4250, 2076,
+	3168, 6581, 7432, 1704, 2899, 1746, 7434, 7435, 7433, 2136, 17368, 2411, 2793, 8710, 1507, 4287,
+	8711, 8712, 7437, 7252, 17371, 7436, 17372, 7440, 7438, 7439, 17373, 7441, 7442, 4306, 7443, 7444,
+	17374, 2955, 3693, 17375, 2913, 1857, 4162, 1675, 2656, 2221, 7447, 7446, 2527, 7448, 7445, 2452,
+	8713, 7452, 6875, 17378, 4660, 17379, 2907, 7450, 17380, 8714, 4235, 3559, 1515, 8716, 2981, 2560,
+	8717, 3877, 1536, 4307, 4211, 7453, 3421, 2629, 7454, 7455, 2864, 7456, 4308, 7451, 7458, 7460,
+	7459, 7457, 17382, 7461, 7462, 3098, 1664, 17383, 7463, 4066, 7464, 7465, 4334, 8718, 17385, 5469,
+	7466, 8719, 7467, 7468, 17386, 7472, 7469, 7470, 17387, 7471, 7473, 8720, 8721, 8722, 2950, 17388,
+	4129, 17389, 2951, 8723, 17390, 7474, 3749, 7475, 7759, 4086, 7476, 7477, 7478, 1747, 17391, 7480,
+	2877, 8724, 7481, 8725, 2072, 7482, 7486, 7484, 7485, 1775, 7483, 7487, 7488, 1450, 7489, 7490,
+	8726, 17394, 2794, 17395, 7491, 17396, 1919, 17397, 7494, 7493, 7492, 7037, 3914, 8727, 17398, 17399,
+	7495, 7496, 8728, 17400, 8729, 7497, 7498, 7499, 8730, 7500, 17401, 17402, 1844, 17403, 17404, 17405,
+	8732, 7501, 8731, 17406, 7502, 3575, 17407, 7504, 1625, 8733, 7507, 7506, 1508, 2007, 3893, 3316,
+	2352, 17408, 2320, 2703, 2880, 8735, 7509, 17409, 17410, 7508, 4170, 1858, 3716, 3538, 17411, 17412,
+	2912, 4276, 2127, 8736, 7512, 17415, 7511, 17418, 8737, 3991, 3490, 8738, 17416, 17417, 1552, 7513,
+	7510, 3809, 4210, 7514, 7515, 17419, 7517, 3176, 1751, 1752, 7516, 8740, 8741, 1859, 2180, 8742,
+	8743, 1860, 8744, 8745, 3415, 4294, 8747, 17420, 2222, 7518, 8748, 7519, 7520, 7521, 7523, 7522,
+	3847, 7524, 17421, 17422, 7525, 17423, 7526, 7527, 17425, 17426, 8749, 17427, 17428, 17429, 17430, 7529,
+	7528, 7530, 17431, 8750, 17432, 3750, 6530, 2829, 17433, 17435, 1898, 17436, 17437, 8751, 7531, 17438,
+	7532, 4618, 5667, 3717, 17439, 17440, 1501, 17441, 1437, 2528, 3964, 2820, 7533, 4105, 17442, 7534,
+	4196, 1538, 2477, 7535, 1676, 7536, 17445, 17446, 7537, 17448, 17449, 17450, 7539, 7540, 7542, 7538,
+	7541, 1845, 7543, 7544, 17452, 17453, 17454, 17455, 17456, 17457, 7545, 7546, 17458, 17459, 7547, 7549,
+	17460, 7548, 7551, 7554, 17461, 17462, 7550, 7552, 7553, 7555, 2008, 8752, 17463, 17464, 17465, 2621,
+	7556, 7557, 2321, 8753, 17466, 7558, 17467, 1722, 3619, 7559, 7560, 3254, 3553, 17468, 7561, 17469,
+	17470, 3665, 17471, 3146, 1560, 2052, 2053, 17472, 3279, 7566, 2054, 17473, 1677, 7565, 17474, 7563,
+	7564, 17475, 7562, 7576, 7567, 7568, 17477, 7569, 7570, 7572, 17478, 7571, 17479, 2689, 7573, 8754,
+	8755, 7575, 17480, 1899, 7574, 17481, 3099, 2181, 17482, 7577, 17483, 17484, 8756, 3147, 7578, 8757,
+	8758, 3491, 17485, 17486, 7579, 8759, 7584, 7582, 7581, 7583, 17487, 7580, 8760, 17488, 7586, 8761,
+	8762, 7585, 7588, 17489, 2009, 7587, 7589, 7590, 7592, 7591, 7593, 7595, 7594, 2348, 7596, 17491,
+	7597, 8763, 17493, 1720, 17495, 7598, 7599, 8764, 8765, 2901, 17497, 8766, 7600, 17498, 7601, 17499,
+	7602, 7603, 17500, 8767, 2322, 17502, 7604, 7605, 17503, 7606, 7607, 17504, 7608, 17505, 3683, 7610,
+	3766, 7611, 7609, 7613, 7612, 7614, 8768, 7615, 8769, 17506, 7616, 8770, 17507, 17508, 17509, 17510,
+	7617, 7618, 17511, 7619, 8771, 7620, 7621, 7622, 7623, 7624, 7625, 7626, 17512, 8773, 7627, 7628,
+	5661, 7629, 17513, 6386, 1900, 17514, 1693, 2368, 7631, 7630, 4047, 17516, 7633, 7634, 7632, 7635,
+	4014, 7636, 1971, 8774, 17517, 17518, 8776, 17519, 17520, 4329, 8777, 17521, 7637, 8778, 17522, 17523,
+	8775, 7639, 8779, 8780, 1440, 8781, 7640, 3865, 7638, 7641, 7642, 8782, 7643, 7644, 17524, 8783,
+	7645, 4026, 2457, 17525, 2440, 3023, 17526, 17529, 7646, 17530, 7649, 17531, 7647, 8784, 7650, 17532,
+	2243, 7648, 8785, 7651, 7652, 7653, 7657, 2454, 17534, 3170, 17535, 7658, 7655, 7654, 7656, 8786,
+	17536, 2131, 17537, 17538, 17540, 17539, 7662, 7661, 7660, 17533, 1429, 17544, 8787, 17542, 8788, 8789,
+	7672, 7668, 7669, 7665, 7671, 7667, 1758, 4368, 7670, 7666, 7664, 7663, 17546, 17547, 17548, 17549,
+	7674, 17550, 7677, 17551, 8790, 7676, 7675, 17552, 17553, 17554, 3801, 7673, 1493, 7678, 17556, 7680,
+	17557, 17558, 1771, 7659, 1527, 7682, 8791, 17559, 7681, 7679, 3209, 17562, 17563, 8792, 8793, 4028,
+	8794, 17565, 4288, 17566, 7683, 17567, 17560, 17568, 17569, 7684, 8795, 8796, 7685, 17570, 17571, 17572,
+	17573, 17574, 17575, 17576, 17577, 7686, 8797, 7687, 17578, 3317, 17579, 7688, 3689, 7693, 7689, 7690,
+	17580, 3965, 4078, 3526, 17582, 7694, 7695, 3508, 7692, 7691, 17581, 1608, 17584, 7703, 7702, 17585,
+	17586, 1589, 8798, 8799, 7700, 7699, 7697, 1783, 7696, 2556, 1607, 17590, 2323, 7706, 7705, 7704,
+	17592, 8800, 7701, 7707, 8801, 7708, 17593, 7713, 7712, 8802, 7714, 1517, 7709, 7710, 17594, 2340,
+	4067, 17595, 8803, 7711, 17597, 17598, 3966, 7719, 8804, 7715, 17600, 17601, 17602, 7720, 8805, 17603,
+	17604, 7717, 7716, 8806, 17605, 2128, 8807, 17606, 7721, 17607, 7722, 7723, 7718, 8809, 7698, 7724,
+	3355, 17609, 7728, 17610, 7729, 7727, 8810, 17611, 17608, 8808, 7726, 7732, 17612, 7725, 7730, 17613,
+	17614, 7731, 7734, 17615, 8811, 7733, 17616, 17617, 17618, 17619, 7736, 8813, 7737, 7738, 4365, 17620,
+	7735, 3177, 2427, 7739, 17623, 8814, 17622, 17624, 8815, 17625, 7740, 7741, 17626, 17627, 7742, 7743,
+	2182, 7744, 17628, 8816, 7745, 2553, 17630, 7746, 17631, 7747, 7748, 7749, 7752, 7750, 4352, 7751,
+	4309, 17632, 7753, 8817, 4289, 8818, 7754, 3666, 17633, 7755, 7757, 8819, 7758, 8820, 8821, 8822,
+	7756, 2333, 4087, 4015, 5069, 17635, 5704, 4039, 8823, 1609, 7760, 1928, 7761, 7762, 7763, 8824,
+	2341, 7764, 5974, 4101, 3169, 7765, 7767, 7766, 17637, 7768, 7769, 17638, 7770, 17639, 7771, 7772,
+	7773, 7774, 7775, 7776, 7777, 7778, 17640, 17641, 17643, 7779, 7780, 3388, 8825, 2223, 7782, 17644,
+	17645, 3053, 7783, 7784, 17646, 17647, 8826, 17648, 3761, 17649, 7785, 17650, 17651, 17652, 7786, 6227,
+	7108, 7503, 7787, 17653, 7788, 17654, 8827, 17655, 17657, 7790, 7791, 7792, 4310, 7789, 7793, 7794,
+	17659, 7796, 7795, 17661, 17662, 7798, 17663, 7799, 7797, 4252, 8828, 8829, 7800, 8830, 7801, 6256,
+	7802, 8831, 1359, 4406, 10147, 7986, 8486, 16957, 17186, 1224, 8837, 8847, 8850, 8858, 8874, 8916,
+	8925, 8923, 9026, 9029, 9031, 9041, 9042, 9048, 9055, 9057, 9066, 9074, 9075, 9081, 9084, 4376,
+	9111, 9128, 9144, 9136, 9135, 9147, 9175, 1350, 9178, 9185, 9180, 9193, 9192, 9200, 1360, 9202,
+	9203, 9217, 9230, 1380, 9242, 9251, 4391, 9265, 9266, 1407, 9306, 9510, 9517, 9518, 9521, 9520,
+	9531, 9526, 9525, 9534, 9536, 9537, 4402, 9553, 9564, 9570, 9872, 17629, 9881, 9886, 9918, 9939,
+	9947, 9952, 9968, 9982, 9973, 9974, 9985, 9984, 9989, 10018, 10062, 10057, 7922, 10061, 10084, 10092,
+	10087, 10086, 10094, 10097, 10099, 10093, 10111, 10113, 10112, 10117, 10145, 10146, 10162, 7978, 10186, 10183,
+	10187, 10184, 10209, 10198, 7999, 8008, 10217, 10226, 10225, 10232, 10237, 16077, 16094, 8054, 16115, 16723,
+	16134, 16138, 16137, 16136, 16186, 16207, 16213, 16233, 16236, 16264, 16281, 16287, 8160, 16313, 16353, 16370,
+	16372, 16377, 16392, 16417, 16421, 16426, 16429, 16441, 16443, 16446, 16451, 16454, 16453, 16458, 16462, 16479,
+	8272, 16490, 8281, 16509, 8311, 16535, 16536, 8324, 16562, 16574, 16573, 16576, 16594, 16605, 16606, 8350,
+	16627, 16628, 16631, 16629, 16637, 16635, 16640, 16642, 16645, 16653, 16652, 16659, 16658, 16667, 16672, 16685,
+	16711, 16713, 16720, 16727, 16753, 16758, 16770, 16786, 16788, 16790, 16793, 16795, 16799, 16806, 16812, 16815,
+	8427, 9248, 16862, 16872, 8479, 16887, 16911, 16921, 16922, 16920, 8501, 16939, 16942, 16989, 16988, 16995,
+	16998, 17003, 17024, 17023, 17026, 8536, 17034, 17042, 17044, 17048, 17068, 17081, 17101, 17103, 17104, 17113,
+	17123, 17126, 17133, 17142, 17148, 8595, 17165, 17170, 8603, 17178, 17183, 17198, 17202, 17203, 17214, 17217,
+	17250, 17249, 17257, 17271, 17272, 17283, 17284, 17296, 17297, 17309, 17308, 17318, 17319, 17326, 17325, 17342,
+	17343, 17351, 17354, 17356, 17357, 17361, 17365, 17367, 17369, 17377, 17392, 17393, 17414, 17424, 17434, 17444,
+	17447, 17451, 17476, 17496, 17527, 17541, 17528, 17545, 17543, 17555, 17564, 17561, 17583, 17589, 17587, 17599,
+	17596, 8812, 17634, 17636, 17656, 17658, 17660, 17664,
+}
@@ -0,0 +1,47 @@
+package jisx4061
+
+// KanaOrder specifies the order of kana.
+type KanaOrder int
+
+const (
+	// KanaOrderGojuon orders kana in the order of the Gojūon (五十音順), あいうえお...わをん. It is the default.
+	KanaOrderGojuon KanaOrder = iota
+
+	// KanaOrderIroha orders kana in the order of the Iroha poem (いろは順), いろはにほへと...ゑひもせす, followed by ん.
+	// Small kana and voiced kana follow their base kana, as in the Gojūon order.
+	KanaOrderIroha
+)
+
+// WithKanaOrder sets the order of kana.
+func WithKanaOrder(order KanaOrder) Option {
+	return func(c *Collator) {
+		c.kanaOrder = order
+	}
+}
+
+// iroha is the kana in the Iroha order.
+const iroha = "いろはにほへとちりぬるをわかよたれそつねならむうゐのおくやまけふこえてあさきゆめみしゑひもせすん"
+
+// irohaOrders maps the orders of kana in the Gojūon order to the orders in the Iroha order.
+var irohaOrders = func() []int {
+	var orders []int
+	i := 1
+	for _, r := range iroha {
+		a, _ := lookup(r)
+		for len(orders) <= a.order {
+			orders = append(orders, 0)
+		}
+		orders[a.order] = i
+		i++
+	}
+	return orders
+}()
+
+// irohaOrder converts the order of kana in the Gojūon order to the order in the Iroha order.
+// The orders of the repeat marks and the long vowel mark, which follow ん, are not changed.
+func irohaOrder(order int) int {
+	if 0 < order && order < len(irohaOrders) {
+		return irohaOrders[order]
+	}
+	return order
+}
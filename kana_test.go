@@ -0,0 +1,97 @@
+package jisx4061
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestKanaOrderIroha(t *testing.T) {
+	c := New(WithKanaOrder(KanaOrderIroha))
+	var list []string
+	for _, r := range iroha {
+		list = append(list, string(r))
+	}
+	for i, a := range list {
+		for j, b := range list {
+			want := compare(i, j)
+			if got := c.Compare(a, b); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+			}
+			if got := bytes.Compare(c.Key(a), c.Key(b)); got != want {
+				t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestKanaOrderIroha_Variants(t *testing.T) {
+	// the small kana, the voiced kana and katakana follow their base kana.
+	c := New(WithKanaOrder(KanaOrderIroha))
+	list := []string{
+		"",
+		"ぃ",
+		"い",
+		"イ",
+		"いい",
+		"ろ",
+		"は",
+		"ハ",
+		"ば",
+		"ぱ",
+		"に",
+		"っ",
+		"つ",
+		"づ",
+		"ね",
+	}
+	for i, a := range list {
+		for j, b := range list {
+			want := compare(i, j)
+			if got := c.Compare(a, b); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+			}
+			if got := bytes.Compare(c.Key(a), c.Key(b)); got != want {
+				t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestKanaOrderIroha_Repeat(t *testing.T) {
+	c := New(WithKanaOrder(KanaOrderIroha))
+	list := []string{
+		"かゝ", // ゝ repeats か
+		"かか",
+		"かう",
+		"かー", // ー takes the vowel あ of か
+		"かあ",
+		"かさ",
+	}
+	for i, a := range list {
+		for j, b := range list {
+			want := compare(i, j)
+			if got := c.Compare(a, b); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestKanaOrderIroha_Sort(t *testing.T) {
+	c := New(WithKanaOrder(KanaOrderIroha))
+	list := []string{"ほ", "いろ", "に", "い", "は", "へ", "ろ"}
+	want := []string{"い", "いろ", "ろ", "は", "に", "ほ", "へ"}
+
+	got := append([]string(nil), list...)
+	c.Sort(got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort: got %q, want %q", got, want)
+	}
+
+	got = append([]string(nil), list...)
+	c.SortByKey(got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SortByKey: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,88 @@
+package jisx4061
+
+import (
+	"sort"
+	"unicode"
+)
+
+// isKanji reports whether r is a kanji.
+// It includes the CJK Unified Ideographs, its extensions and the CJK Compatibility Ideographs,
+// but doesn't include the radicals and the ideographs of other scripts, such as Tangut.
+func isKanji(r rune) bool {
+	// fast path for CJK Unified Ideographs
+	if 0x4e00 <= r && r <= 0x9fff {
+		return true
+	}
+	return unicode.Is(unicode.Han, r) && unicode.Is(unicode.Ideographic, r)
+}
+
+// canonicalKanji converts the CJK compatibility ideograph r to its canonical equivalent.
+// Only the CJK Compatibility Ideographs blocks are looked up, to keep the other kanji fast.
+func canonicalKanji(r rune) rune {
+	if (r < 0xF900 || r > 0xFAFF) && (r < 0x2F800 || r > 0x2FA1F) {
+		return r
+	}
+	if c, ok := cjkCompat[r]; ok {
+		return c
+	}
+	return r
+}
+
+// KanjiOrder specifies the order of kanji that are not in the collation table.
+type KanjiOrder int
+
+const (
+	// KanjiOrderCodePoint orders kanji by their code points. It is the default.
+	KanjiOrderCodePoint KanjiOrder = iota
+
+	// KanjiOrderRadicalStroke orders kanji by their radicals (部首) and then by their residual stroke counts (画数),
+	// as the zh-u-co-unihan collation of ICU 72 (Unicode 15.0) does.
+	// The kanji with the same radical and residual stroke count are not ordered by their code points,
+	// but the CJK Unified Ideographs block comes first, e.g. 丝 (U+4E1D) < 㐀 (U+3400).
+	// The data covers the CJK Unified Ideographs and its extensions in Unicode 15.0.
+	// The kanji that have no radical data are sorted after the others by their code points.
+	KanjiOrderRadicalStroke
+
+	// KanjiOrderJIS orders kanji by their positions (面区点) in JIS X 0208 and its superset JIS X 0213.
+	// The JIS X 0208 第1水準 kanji come in the order of their readings (音読み), and the 第2水準 kanji come in the radical-stroke order.
+	// The kanji that are not in JIS X 0213 are sorted after the others by their code points.
+	KanjiOrderJIS
+)
+
+// WithKanjiOrder sets the order of kanji.
+func WithKanjiOrder(order KanjiOrder) Option {
+	return func(c *Collator) {
+		c.kanjiOrder = order
+	}
+}
+
+// kanjiOrderOffset leaves room for the kanji in the table, such as 〃, 仝 and 々.
+const kanjiOrderOffset = 0x10
+
+// orderOfKanji returns the order (番号) of the kanji r.
+func (c *Collator) orderOfKanji(r rune) int {
+	r = canonicalKanji(r)
+	switch c.kanjiOrder {
+	case KanjiOrderRadicalStroke:
+		if rank, ok := radicalStrokeRankOf(r); ok {
+			return kanjiOrderOffset + rank
+		}
+		return kanjiOrderOffset + radicalStrokeCount + int(r)
+	case KanjiOrderJIS:
+		if i := sort.Search(len(jisRunes), func(i int) bool { return jisRunes[i] >= r }); i < len(jisRunes) && jisRunes[i] == r {
+			return kanjiOrderOffset + int(jisPositions[i])
+		}
+		return kanjiOrderOffset + jisPositionCount + int(r)
+	}
+	return int(r)
+}
+
+// radicalStrokeRankOf returns the rank of the kanji r in the radical-stroke order.
+func radicalStrokeRankOf(r rune) (int, bool) {
+	i := sort.Search(len(radicalStrokeRanges), func(i int) bool { return radicalStrokeRanges[i].hi >= r })
+	if i < len(radicalStrokeRanges) && radicalStrokeRanges[i].lo <= r {
+		rg := radicalStrokeRanges[i]
+		return int(radicalStrokeRank[rg.offset+int(r-rg.lo)]), true
+	}
+	return 0, false
+}
@@ -0,0 +1,151 @@
+package jisx4061
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestIsKanji(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want bool
+	}{
+		{'漢', true},
+		{'㐀', true},           // U+3400 CJK Unified Ideographs Extension A
+		{'𠮷', true},           // U+20BB7 CJK Unified Ideographs Extension B
+		{'\U00030000', true},  // U+30000 CJK Unified Ideographs Extension G
+		{'\uf9dc', true},      // U+F9DC CJK Compatibility Ideograph
+		{'⼀', false},          // U+2F00 Kangxi Radical
+		{'ꀀ', false},          // U+A000 Yi Syllable
+		{'한', false},          // U+D55C Hangul Syllable
+		{'\ue000', false},     // U+E000 Private Use Area
+		{'ｱ', false},          // U+FF71 Halfwidth Katakana
+		{'﹅', false},          // U+FE45 CJK Compatibility Forms
+		{'\U00017000', false}, // U+17000 Tangut
+	}
+	for _, tt := range tests {
+		if got := isKanji(tt.r); got != tt.want {
+			t.Errorf("isKanji(%U) = %t, want %t", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestKanji_Extensions(t *testing.T) {
+	list := []string{"吉野家", "𠮷野家"}
+	for i, a := range list {
+		for j, b := range list {
+			want := i < j
+			if got := Less(a, b); got != want {
+				t.Errorf("want %s < %s is %t, but not", a, b, want)
+			}
+		}
+	}
+
+	// the kanji in the extensions must not be ignored.
+	if Compare("𠮷野家", "野家") == 0 {
+		t.Errorf("want %q != %q, but not", "𠮷野家", "野家")
+	}
+	if Compare("㐂", "") == 0 {
+		t.Errorf("want %q != %q, but not", "㐂", "")
+	}
+}
+
+func TestKanji_Compatibility(t *testing.T) {
+	tests := []struct {
+		compat, canonical string
+	}{
+		{"\uf9dc", "隆"},
+		{"\ufa10", "塚"},
+		{"\U0002f800", "丽"},
+	}
+	for _, tt := range tests {
+		if got := Compare(tt.compat, tt.canonical); got != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", tt.compat, tt.canonical, got)
+		}
+		if !bytes.Equal(Key(tt.compat), Key(tt.canonical)) {
+			t.Errorf("want Key(%q) == Key(%q), but not", tt.compat, tt.canonical)
+		}
+	}
+}
+
+func TestKanji_NotKanji(t *testing.T) {
+	// Hangul and Yi syllables are not kanji, so they are ignored by default.
+	tests := []string{"한", "ꀀ", "\ue000"}
+	for _, s := range tests {
+		if got := Compare(s, ""); got != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", s, "", got)
+		}
+	}
+}
+
+func TestKanjiOrderRadicalStroke(t *testing.T) {
+	c := New(WithKanjiOrder(KanjiOrderRadicalStroke))
+	list := []string{
+		"々", "〆",
+		"一", "丁", "𠀀", "万", "㐀", // 一部
+		"丨",      // 丨部
+		"京",      // 亠部
+		"人", "休", // 人部
+		"吉", "吏", "𠮷", // 口部
+		"水", "永", "海", "漢", // 水部
+		"辻", "込", "巡", "迂", // 辵部
+		"阪", "防", "陀", // 阜部
+		"龠", // 龠部
+	}
+	for i, a := range list {
+		for j, b := range list {
+			want := compare(i, j)
+			if got := c.Compare(a, b); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+			}
+			if got := bytes.Compare(c.Key(a), c.Key(b)); got != want {
+				t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+
+	// the code point order is different.
+	tests := [][2]string{
+		{"㐀", "一"},
+		{"巡", "込"},
+		{"丨", "𠀀"},
+		{"㐀", "丝"}, // the same radical and residual stroke count
+	}
+	for _, tt := range tests {
+		if !Less(tt[0], tt[1]) {
+			t.Errorf("want %q < %q, but not", tt[0], tt[1])
+		}
+		if !c.Less(tt[1], tt[0]) {
+			t.Errorf("want %q < %q in the radical-stroke order, but not", tt[1], tt[0])
+		}
+	}
+}
+
+func TestKanjiOrderJIS(t *testing.T) {
+	c := New(WithKanjiOrder(KanjiOrderJIS))
+	list := []string{
+		"々", "〆",
+		"俱",                // 1-14-1 (JIS X 0213)
+		"亜", "唖", "娃", "阿", // 1-16-1 .. 1-16-4 (第1水準)
+		"腕", // 1-47-51
+		"弌", // 1-48-1 (第2水準)
+		"熙", // 1-84-6
+		"顗", // 1-94-1 (JIS X 0213)
+		"𠂉", // 2-1-1 (JIS X 0213)
+		"𪚲", // 2-94-86
+
+		// not in JIS X 0213
+		"㐀", "𠀀",
+	}
+	for i, a := range list {
+		for j, b := range list {
+			want := compare(i, j)
+			if got := c.Compare(a, b); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+			}
+			if got := bytes.Compare(c.Key(a), c.Key(b)); got != want {
+				t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
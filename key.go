@@ -0,0 +1,78 @@
+package jisx4061
+
+// Key returns the sort key of s.
+// Comparing two sort keys with [bytes.Compare] gives the same result as comparing the original strings with [Compare].
+func Key(s string) []byte {
+	return defaultCollator.Key(s)
+}
+
+// AppendKey appends the sort key of s to dst and returns the extended buffer.
+// See [Key] for details.
+func AppendKey(dst []byte, s string) []byte {
+	return defaultCollator.AppendKey(dst, s)
+}
+
+// Key returns the sort key of s.
+// Comparing two sort keys with [bytes.Compare] gives the same result as comparing the original strings with [Collator.Compare].
+func (c *Collator) Key(s string) []byte {
+	return c.AppendKey(nil, s)
+}
+
+// AppendKey appends the sort key of s to dst and returns the extended buffer.
+// See [Collator.Key] for details.
+func (c *Collator) AppendKey(dst []byte, s string) []byte {
+	var buf [32]attr
+	attrs := buf[:0]
+	it := c.newIter(s)
+	for {
+		a, ok := it.next()
+		if !ok {
+			break
+		}
+		attrs = append(attrs, a)
+	}
+
+	// 文字クラス and 番号.
+	// The class is shifted by one so that every character is greater than the terminator.
+	for _, a := range attrs {
+		dst = append(dst, byte(a.class)+1)
+		dst = appendOrder(dst, a.order)
+		if c.subRanks {
+			dst = appendOrder(dst, a.subRank(Primary))
+		}
+	}
+	dst = append(dst, 0)
+
+	// The lower levels have the same length if the upper levels are equal,
+	// so they need no terminators.
+	for level := Secondary; level <= Senary; level++ {
+		if c.ignores(level) {
+			continue
+		}
+		for _, a := range attrs {
+			dst = append(dst, byte(a.weight(level)))
+			if c.subRanks {
+				// the encoding of appendOrder is prefix-free, so the sub-ranks need no terminators either.
+				dst = appendOrder(dst, a.subRank(level))
+			}
+		}
+	}
+
+	// The tiebreak weights are at the end of the key, so they need no terminator.
+	if c.strength >= Senary && c.hasTiebreak() {
+		for _, w := range it.tiebreak {
+			dst = appendOrder(dst, w)
+		}
+	}
+	return dst
+}
+
+// appendOrder appends order in the order preserving variable length encoding.
+// Small orders are encoded in two bytes, and the others are encoded in four bytes with the most significant bit set.
+// order must not exceed [MaxOrder].
+func appendOrder(dst []byte, order int) []byte {
+	if order < 0x8000 {
+		return append(dst, byte(order>>8), byte(order))
+	}
+	return append(dst, byte(order>>24)|0x80, byte(order>>16), byte(order>>8), byte(order))
+}
@@ -0,0 +1,44 @@
+package jisx4061
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	for _, tt := range lessTests {
+		for _, a := range tt {
+			for _, b := range tt {
+				want := Compare(a, b)
+				got := bytes.Compare(Key(a), Key(b))
+				if got != want {
+					t.Errorf("want bytes.Compare(Key(%q), Key(%q)) is %d, got %d", a, b, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestKey_Equal(t *testing.T) {
+	tests := [][2]string{
+		{"", ""},
+		{"a", "ａ"},
+		{"あ", "あ"},
+		{"カ", "カ"},
+		{"漢字", "漢字"},
+	}
+	for _, tt := range tests {
+		if !bytes.Equal(Key(tt[0]), Key(tt[1])) {
+			t.Errorf("want Key(%q) == Key(%q), but not", tt[0], tt[1])
+		}
+	}
+}
+
+func TestAppendKey(t *testing.T) {
+	prefix := []byte("prefix")
+	got := AppendKey(prefix, "さとう")
+	want := append([]byte("prefix"), Key("さとう")...)
+	if !bytes.Equal(got, want) {
+		t.Errorf("want %x, got %x", want, got)
+	}
+}
@@ -0,0 +1,64 @@
+package jisx4061
+
+import "bytes"
+
+// SortByKey sorts s.
+// It computes the sort key of each element only once, and then sorts the keys.
+// It is faster than [Sort] for large slices, but it uses more memory.
+// The sort is stable.
+func SortByKey(s []string) {
+	defaultCollator.SortByKey(s)
+}
+
+// SortByKey sorts s with the collator.
+// It computes the sort key of each element only once, and then sorts the keys.
+// It is faster than [Collator.Sort] for large slices, but it uses more memory.
+// The sort is stable.
+func (c *Collator) SortByKey(s []string) {
+	sortByKey(c, s, func(s string) string { return s })
+}
+
+// SortFuncByKey sorts s by the strings that key returns.
+// It computes the sort key of each element only once, and then sorts the keys.
+// It is faster than [SortFunc] for large slices, but it uses more memory.
+// The sort is stable.
+func SortFuncByKey[T any](s []T, key func(T) string) {
+	sortByKey(defaultCollator, s, key)
+}
+
+type keyed struct {
+	key []byte
+	idx int
+}
+
+func sortByKey[T any](c *Collator, s []T, key func(T) string) {
+	items := make([]keyed, len(s))
+	offsets := make([]int, len(s)+1)
+
+	// share one buffer for all keys to reduce allocations.
+	var buf []byte
+	for i, v := range s {
+		buf = c.AppendKey(buf, key(v))
+		offsets[i+1] = len(buf)
+	}
+	for i := range s {
+		items[i] = keyed{
+			key: buf[offsets[i]:offsets[i+1]:offsets[i+1]],
+			idx: i,
+		}
+	}
+
+	// the index breaks ties, so the result is stable.
+	sortFunc(items, func(a, b keyed) int {
+		if ret := bytes.Compare(a.key, b.key); ret != 0 {
+			return ret
+		}
+		return compare(a.idx, b.idx)
+	})
+
+	sorted := make([]T, len(s))
+	for i, item := range items {
+		sorted[i] = s[item.idx]
+	}
+	copy(s, sorted)
+}
@@ -0,0 +1,80 @@
+package jisx4061
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestSortByKey(t *testing.T) {
+	for _, tt := range lessTests {
+		list := make([]string, len(tt))
+		for i, j := range rand.New(rand.NewSource(42)).Perm(len(tt)) {
+			list[i] = tt[j]
+		}
+		SortByKey(list)
+		for i := range tt {
+			if list[i] != tt[i] {
+				t.Errorf("want %v, got %v", tt, list)
+				break
+			}
+		}
+	}
+}
+
+func TestSortFuncByKey(t *testing.T) {
+	products := []product{
+		{"ｂ", 1},
+		{"さと", 2},
+		{"b", 3},
+		{"ａ", 4},
+	}
+	SortFuncByKey(products, productName)
+	want := []product{
+		{"ａ", 4},
+		{"ｂ", 1},
+		{"b", 3},
+		{"さと", 2},
+	}
+	for i := range want {
+		if products[i] != want[i] {
+			t.Errorf("want %v, got %v", want, products)
+			break
+		}
+	}
+}
+
+// randomStrings returns n random strings of kana and Latin alphabets.
+func randomStrings(n int) []string {
+	r := rand.New(rand.NewSource(42))
+	chars := []rune("あいうえおかがきぎくぐさざしじたちつってとなにはばぱひびぴまみやゃゆゅよょらりわをんアイウカキクサシスタチツーヽabcABC")
+	list := make([]string, n)
+	for i := range list {
+		s := make([]rune, 3+r.Intn(10))
+		for j := range s {
+			s[j] = chars[r.Intn(len(chars))]
+		}
+		list[i] = string(s)
+	}
+	return list
+}
+
+func BenchmarkSort(b *testing.B) {
+	list := randomStrings(10000)
+	data := make([]string, len(list))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(data, list)
+		sort.Sort(StringSlice(data))
+	}
+}
+
+func BenchmarkSortByKey(b *testing.B) {
+	list := randomStrings(10000)
+	data := make([]string, len(list))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(data, list)
+		SortByKey(data)
+	}
+}
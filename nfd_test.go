@@ -0,0 +1,58 @@
+package jisx4061
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCombiningVoicedMark(t *testing.T) {
+	nfc := []rune("がぎぐげござじずぜぞだぢづでどばびぶべぼぱぴぷぺぽゞガギグゲゴザジズゼゾダヂヅデドバビブベボパピプペポヴヾゔヷヸヹヺ")
+	nfd := []string{
+		"か\u3099", "き\u3099", "く\u3099", "け\u3099", "こ\u3099", "さ\u3099", "し\u3099", "す\u3099", "せ\u3099", "そ\u3099",
+		"た\u3099", "ち\u3099", "つ\u3099", "て\u3099", "と\u3099", "は\u3099", "ひ\u3099", "ふ\u3099", "へ\u3099", "ほ\u3099",
+		"は\u309a", "ひ\u309a", "ふ\u309a", "へ\u309a", "ほ\u309a", "ゝ\u3099",
+		"カ\u3099", "キ\u3099", "ク\u3099", "ケ\u3099", "コ\u3099", "サ\u3099", "シ\u3099", "ス\u3099", "セ\u3099", "ソ\u3099",
+		"タ\u3099", "チ\u3099", "ツ\u3099", "テ\u3099", "ト\u3099", "ハ\u3099", "ヒ\u3099", "フ\u3099", "ヘ\u3099", "ホ\u3099",
+		"ハ\u309a", "ヒ\u309a", "フ\u309a", "ヘ\u309a", "ホ\u309a", "ウ\u3099", "ヽ\u3099",
+		"う\u3099", "ワ\u3099", "ヰ\u3099", "ヱ\u3099", "ヲ\u3099",
+	}
+	for i := range nfc {
+		// the prefix gives the repeat marks a character to repeat.
+		a := "す" + string(nfc[i])
+		b := "す" + nfd[i]
+		if got := Compare(a, b); got != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", a, b, got)
+		}
+		if !bytes.Equal(Key(a), Key(b)) {
+			t.Errorf("want Key(%q) == Key(%q), but not", a, b)
+		}
+	}
+}
+
+func TestCombiningVoicedMark_Strings(t *testing.T) {
+	tests := []struct {
+		nfc, nfd string
+	}{
+		{"がっこう", "か\u3099っこう"},
+		{"いすゞ", "いすゝ\u3099"},
+		{"パーティー", "ハ\u309aーティー"},
+		{"ぶぷ", "ふ\u3099ふ\u309a"},
+		{"データ.txt", "テ\u3099ータ.txt"},
+	}
+	for _, tt := range tests {
+		if got := Compare(tt.nfc, tt.nfd); got != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", tt.nfc, tt.nfd, got)
+		}
+	}
+
+	// the voiced kana in NFD must sort between the unvoiced ones.
+	list := []string{"か", "か\u3099", "かき", "は", "は\u3099", "は\u309a", "はは"}
+	for i, a := range list {
+		for j, b := range list {
+			want := i < j
+			if got := Less(a, b); got != want {
+				t.Errorf("want %q < %q is %t, but not", a, b, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package jisx4061
+
+// numberForm returns the value of the number form r, such as ①, ⑴, ⒈, Ⅻ and ⅻ,
+// and the attribute that has the lower level weights of the form.
+// Number forms are compared as numbers, and then by their forms at the lower levels.
+func numberForm(r rune) (value int, form attr, ok bool) {
+	switch {
+	case 0x2460 <= r && r <= 0x2473: // ①..⑳
+		return int(r-0x2460) + 1, attr{symbolType: symbolTypeCircled}, true
+	case 0x2474 <= r && r <= 0x2487: // ⑴..⒇
+		return int(r-0x2474) + 1, attr{symbolType: symbolTypeParenthesized}, true
+	case 0x2488 <= r && r <= 0x249b: // ⒈..⒛
+		return int(r-0x2488) + 1, attr{symbolType: symbolTypeFullStop}, true
+	case 0x2160 <= r && r <= 0x217f: // Ⅰ..Ⅿ and ⅰ..ⅿ
+		var value int
+		switch i := int(r-0x2160) % 16; i {
+		case 12:
+			value = 50 // Ⅼ
+		case 13:
+			value = 100 // Ⅽ
+		case 14:
+			value = 500 // Ⅾ
+		case 15:
+			value = 1000 // Ⅿ
+		default:
+			value = i + 1
+		}
+		form := attr{symbolType: symbolTypeRoman, letterCase: letterCaseUpper}
+		if r >= 0x2170 {
+			form.letterCase = letterCaseLower
+		}
+		return value, form, true
+	}
+	return 0, attr{}, false
+}
+
+// pushNumberForm pushes the attributes of the number form into the buffer.
+// In the numeric mode, the value is compared as a number.
+// Otherwise it is compared digit by digit, as if it were written in Arabic numerals.
+func (it *iter) pushNumberForm(value int, form attr) {
+	digits := decimalDigits(int64(value))
+	if it.c.numeric {
+		it.pushNumber(digits, form)
+		return
+	}
+	form.class = classNumber
+	for _, d := range digits {
+		form.order = d + 1
+		it.buf = append(it.buf, form)
+	}
+}
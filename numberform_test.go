@@ -0,0 +1,85 @@
+package jisx4061
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNumberForm(t *testing.T) {
+	tests := []struct {
+		name string
+		c    *Collator
+		list []string
+	}{
+		{
+			name: "digit by digit",
+			c:    New(),
+			list: []string{
+				"1", "①", "⑴", "⒈", "ⅰ", "Ⅰ",
+				"10", "⑩", "ⅹ", "Ⅹ",
+				"11", "⑪", "Ⅺ",
+				"12", "ⅻ", "Ⅻ",
+				"2", "②", "Ⅱ",
+				"50", "Ⅼ",
+				"a",
+			},
+		},
+		{
+			name: "numeric",
+			c:    New(Numeric()),
+			list: []string{
+				"1", "①", "⑴", "⒈", "ⅰ", "Ⅰ",
+				"2", "②", "Ⅱ",
+				"10", "⑩", "ⅹ", "Ⅹ",
+				"11", "⑪", "Ⅺ",
+				"12", "ⅻ", "Ⅻ",
+				"50", "Ⅼ",
+				"a",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, a := range tt.list {
+				for j, b := range tt.list {
+					want := compare(i, j)
+					if got := tt.c.Compare(a, b); got != want {
+						t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+					}
+					if got := bytes.Compare(tt.c.Key(a), tt.c.Key(b)); got != want {
+						t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", a, b, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNumberForm_Equal(t *testing.T) {
+	tests := []struct {
+		c    *Collator
+		a, b string
+	}{
+		{New(WithStrength(Secondary)), "①", "1"},
+		{New(WithStrength(Secondary)), "⑫", "12"},
+		{New(IgnoreCase()), "ⅻ", "Ⅻ"},
+		{New(Numeric(), WithStrength(Secondary)), "第⑫章", "第12章"},
+		{New(Numeric(), WithStrength(Secondary)), "Ⅿ", "1000"},
+	}
+	for _, tt := range tests {
+		if got := tt.c.Compare(tt.a, tt.b); got != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", tt.a, tt.b, got)
+		}
+	}
+}
+
+func TestNumberForm_NotJoined(t *testing.T) {
+	// a number form is not joined with the adjacent digits.
+	c := New(Numeric())
+	if got := c.Compare("1①", "11"); got != -1 {
+		t.Errorf("Compare(%q, %q) = %d, want -1", "1①", "11", got)
+	}
+	if got := c.Compare("①2", "3"); got != -1 {
+		t.Errorf("Compare(%q, %q) = %d, want -1", "①2", "3", got)
+	}
+}
@@ -0,0 +1,183 @@
+package jisx4061
+
+import "strconv"
+
+// Numeric makes the collator compare runs of digits (アラビア数字) by their numeric values.
+// ASCII digits and full-width digits may be mixed in a run, so "file9" < "file10" and "１0" == "10".
+// Numbers that differ only in the leading zeros, such as "1" and "01", are ordered by the number of the leading zeros
+// after all levels.
+func Numeric() Option {
+	return func(c *Collator) {
+		c.numeric = true
+	}
+}
+
+// readNumber reads the run of digits at the current position,
+// and pushes the attributes of its numeric value into the buffer.
+func (it *iter) readNumber() {
+	var digits []int
+	for it.i < len(it.s) {
+		a, r, n := it.c.getAttr(it.s[it.i:], it.last)
+		if a.class != classNumber {
+			break
+		}
+		if _, _, ok := numberForm(r); ok {
+			// numbers such as ① are not a part of the run.
+			break
+		}
+		digits = append(digits, a.order-1) // the order of 0 is 1
+		it.i += n
+		it.setLast(r)
+	}
+	it.pushNumber(digits, attr{})
+}
+
+// pushNumber pushes the attributes of the number into the buffer.
+// The number is represented by the count of its significant digits followed by the digits,
+// so shorter numbers are smaller than longer ones.
+// The lower level weights are copied from form.
+// The count of the leading zeros is pushed into the tiebreak weights.
+func (it *iter) pushNumber(digits []int, form attr) {
+	zeros := 0
+	for zeros < len(digits) && digits[zeros] == 0 {
+		zeros++
+	}
+	digits = digits[zeros:]
+
+	form.class = classNumber
+	form.order = len(digits)
+	it.buf = append(it.buf, form)
+	for _, d := range digits {
+		form.order = d + 1
+		it.buf = append(it.buf, form)
+	}
+	it.tiebreak = append(it.tiebreak, zeros)
+}
+
+// KanjiNumerals makes the collator compare runs of kanji numerals (漢数字) by their numeric values, so "第九条" < "第十条" < "第百条".
+// Both the positional form, such as "二〇二三", and the multiplicative form, such as "二千二十三", are supported.
+// The numerals are 〇一二三四五六七八九, 十百千万億 and the formal variants 壱弐参.
+// Note that the kanji numerals in ordinary words, such as "一般", are also compared as numbers.
+// Kanji numerals are compared after Arabic numerals with the same value at the tiebreak level.
+// It also enables [Numeric].
+func KanjiNumerals() Option {
+	return func(c *Collator) {
+		c.numeric = true
+		c.kanjiNumerals = true
+	}
+}
+
+var kanjiDigits = map[rune]int{
+	'〇': 0,
+	'一': 1,
+	'壱': 1,
+	'二': 2,
+	'弐': 2,
+	'三': 3,
+	'参': 3,
+	'四': 4,
+	'五': 5,
+	'六': 6,
+	'七': 7,
+	'八': 8,
+	'九': 9,
+}
+
+// kanjiUnits are the multipliers in a section of four digits.
+var kanjiUnits = map[rune]int{
+	'十': 10,
+	'百': 100,
+	'千': 1000,
+}
+
+// kanjiSectionUnits are the multipliers of the sections.
+var kanjiSectionUnits = map[rune]int{
+	'万': 10000,
+	'億': 100000000,
+}
+
+// kanjiNumberLimit bounds the values of kanji numerals in the multiplicative form to avoid overflow.
+const kanjiNumberLimit = 1 << 56
+
+// isKanjiNumeral reports whether r is a kanji numeral.
+func isKanjiNumeral(r rune) bool {
+	if _, ok := kanjiDigits[r]; ok {
+		return true
+	}
+	if _, ok := kanjiUnits[r]; ok {
+		return true
+	}
+	_, ok := kanjiSectionUnits[r]
+	return ok
+}
+
+// readKanjiNumber reads the run of kanji numerals at the current position,
+// and pushes the attributes of its numeric value into the buffer.
+// The orders of the kanji are pushed into the tiebreak weights.
+func (it *iter) readKanjiNumber() {
+	var digits, orders []int
+	var total, section, cur int64
+	// hasCur and hasSection report whether a numeral has been read
+	// since the last unit and the last section unit, respectively.
+	var hasCur, hasSection bool
+	positional := true
+	for it.i < len(it.s) {
+		a, r, n := it.c.getAttr(it.s[it.i:], it.last)
+		if a.class != classKanji {
+			break
+		}
+		if d, ok := kanjiDigits[r]; ok {
+			if !positional && cur >= 10000 {
+				break
+			}
+			digits = append(digits, d)
+			if cur < 10000 {
+				cur = cur*10 + int64(d)
+			}
+			hasCur, hasSection = true, true
+		} else if u, ok := kanjiUnits[r]; ok {
+			if cur >= 10000 || total >= kanjiNumberLimit {
+				break
+			}
+			if !hasCur {
+				cur = 1 // 十 means 一十
+			}
+			section += cur * int64(u)
+			cur = 0
+			hasCur, hasSection = false, true
+			positional = false
+		} else if u, ok := kanjiSectionUnits[r]; ok {
+			if cur >= 10000 || total >= kanjiNumberLimit {
+				break
+			}
+			section += cur
+			if !hasSection {
+				section = 1 // 万 means 一万, but 〇万 means 0
+			}
+			total += section * int64(u)
+			section, cur = 0, 0
+			hasCur, hasSection = false, false
+			positional = false
+		} else {
+			break
+		}
+		orders = append(orders, a.order)
+		it.i += n
+		it.setLast(r)
+	}
+
+	if !positional {
+		digits = decimalDigits(total + section + cur)
+	}
+	it.pushNumber(digits, attr{})
+	it.tiebreak = append(it.tiebreak, orders...)
+}
+
+// decimalDigits returns the decimal digits of the non-negative integer v.
+func decimalDigits(v int64) []int {
+	var digits []int
+	for _, d := range strconv.FormatInt(v, 10) {
+		digits = append(digits, int(d-'0'))
+	}
+	return digits
+}
@@ -0,0 +1,168 @@
+package jisx4061
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNumeric(t *testing.T) {
+	c := New(Numeric())
+	list := []string{
+		"",
+		"0",
+		"00",
+		"1",
+		"01",
+		"001",
+		"2",
+		"9",
+		"10",
+		"010",
+		"11",
+		"99",
+		"100",
+		"1000000000000000000000000",
+		"a",
+		"file1",
+		"file2",
+		"file9",
+		"file10",
+		"file10a",
+		"file10b",
+		"file11",
+		"file1000",
+	}
+	for i, a := range list {
+		for j, b := range list {
+			want := compare(i, j)
+			if got := c.Compare(a, b); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+			}
+			if got := bytes.Compare(c.Key(a), c.Key(b)); got != want {
+				t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestNumeric_Equal(t *testing.T) {
+	c := New(Numeric())
+	tests := [][2]string{
+		{"10", "１０"},
+		{"10", "1０"},
+		{"番地１２", "番地12"},
+	}
+	for _, tt := range tests {
+		if got := c.Compare(tt[0], tt[1]); got != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", tt[0], tt[1], got)
+		}
+		if !bytes.Equal(c.Key(tt[0]), c.Key(tt[1])) {
+			t.Errorf("want Key(%q) == Key(%q), but not", tt[0], tt[1])
+		}
+	}
+}
+
+func TestNumeric_LeadingZeros(t *testing.T) {
+	// the leading zeros are ignored if the collator doesn't compare all levels.
+	c := New(Numeric(), WithStrength(Quinary))
+	if got := c.Compare("1", "01"); got != 0 {
+		t.Errorf("Compare(%q, %q) = %d, want 0", "1", "01", got)
+	}
+
+	// the leading zeros are compared after the other levels.
+	c = New(Numeric())
+	if got := c.Compare("01あ", "1ア"); got != -1 {
+		t.Errorf("Compare(%q, %q) = %d, want -1", "01あ", "1ア", got)
+	}
+}
+
+func TestKanjiNumerals(t *testing.T) {
+	c := New(KanjiNumerals())
+	list := []string{
+		"〇",
+		"一",
+		"二",
+		"二〇",
+		"二十",
+		"二十三",
+		"九十九",
+		"百",
+		"百二",
+		"千九百八十四",
+		"二〇二三",
+		"一万",
+		"三万五千",
+		"一億二千万",
+		"第九条",
+		"第十条",
+		"第百条",
+		"第百二条",
+	}
+	for i, a := range list {
+		for j, b := range list {
+			want := compare(i, j)
+			if got := c.Compare(a, b); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+			}
+			if got := bytes.Compare(c.Key(a), c.Key(b)); got != want {
+				t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestKanjiNumerals_Value(t *testing.T) {
+	c := New(KanjiNumerals(), WithStrength(Quinary))
+	tests := [][2]string{
+		{"一丁目", "1丁目"},
+		{"二十三番", "23番"},
+		{"二三番", "23番"},
+		{"千九百八十四", "1984"},
+		{"一九八四", "1984"},
+		{"一億二千万", "120000000"},
+		{"五十億", "5000000000"}, // overflows int32
+		{"二〇万", "200000"},
+		{"〇万", "0"},
+		{"〇十", "0"},
+		{"一億万", "100010000"},
+		{"壱万", "10000"},
+		{"弐拾", "2拾"}, // 拾 is not supported
+		{"参", "三"},
+	}
+	for _, tt := range tests {
+		if got := c.Compare(tt[0], tt[1]); got != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", tt[0], tt[1], got)
+		}
+	}
+
+	// the kanji numerals are compared after the Arabic numerals.
+	c = New(KanjiNumerals())
+	tests = [][2]string{
+		{"1丁目", "一丁目"},
+		{"三", "参"},
+		{"二〇", "二十"},
+	}
+	for _, tt := range tests {
+		if got := c.Compare(tt[0], tt[1]); got != -1 {
+			t.Errorf("Compare(%q, %q) = %d, want -1", tt[0], tt[1], got)
+		}
+	}
+}
+
+func TestKanjiNumerals_Yomi(t *testing.T) {
+	c := New(KanjiNumerals(), WithYomi(testYomi))
+	list := []string{
+		"とうきょう9",
+		"東京九",
+		"東京十",
+		"とうきょう11",
+	}
+	for i, a := range list {
+		for j, b := range list {
+			want := compare(i, j)
+			if got := c.Compare(a, b); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package jisx4061
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRepeat_Equal(t *testing.T) {
+	c := New(WithStrength(Secondary))
+	tests := [][2]string{
+		// 繰返し記号 repeats the same kana in a sequence.
+		{"いすゞゞ", "いすずず"},
+		{"ぶゝゝ", "ぶふふ"},
+		{"すゞゝ", "すずす"},
+		{"カヽヽ", "カカカ"},
+
+		// 長音記号 after 繰返し記号 takes the vowel of the repeated kana.
+		{"かゝー", "かかあ"},
+
+		// 々 repeats the last kanji.
+		{"人々", "人人"},
+		{"時々刻々", "時時刻刻"},
+		{"人々々", "人人人"},
+
+		// くの字点 repeats the last two kana.
+		{"いろ〱", "いろいろ"},
+		{"しみ〲", "しみじみ"},
+		{"しみ〳〵", "しみしみ"},
+		{"しみ〴〵", "しみじみ"},
+		{"ぐる〱", "ぐるぐる"},
+		{"あ〱", "ああ"},
+	}
+	for _, tt := range tests {
+		if got := c.Compare(tt[0], tt[1]); got != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", tt[0], tt[1], got)
+		}
+		if !bytes.Equal(c.Key(tt[0]), c.Key(tt[1])) {
+			t.Errorf("want Key(%q) == Key(%q), but not", tt[0], tt[1])
+		}
+	}
+}
+
+func TestRepeat_NoBase(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		// repeat marks without the repeated characters are compared as themselves.
+		{"ゝあ", "ゝ", 1},
+		{"〱", "ゝ", 0},
+		{"々", "人", -1},
+		{"a々", "a人", -1},
+		{"aゝ", "aa", 1},
+	}
+	c := New(WithStrength(Secondary))
+	for _, tt := range tests {
+		if got := c.Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestRepeat_Yomi(t *testing.T) {
+	// 々 repeats the reading of the last kanji.
+	c := New(WithYomi(testYomi), WithStrength(Primary))
+	tests := [][2]string{
+		{"東々", "ひがしひがし"},
+		{"西東々", "にしひがしひがし"},
+	}
+	for _, tt := range tests {
+		if got := c.Compare(tt[0], tt[1]); got != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", tt[0], tt[1], got)
+		}
+	}
+}
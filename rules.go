@@ -0,0 +1,282 @@
+package jisx4061
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RuleError is an error in tailoring rules.
+type RuleError struct {
+	// Offset is the byte offset of the error in the rules.
+	Offset int
+
+	// Msg describes the error.
+	Msg string
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("jisx4061: rule at offset %d: %s", e.Offset, e.Msg)
+}
+
+// rule is a reset or a relation of the tailoring rules.
+type rule struct {
+	reset bool
+	level Strength // the level of the relation, or levelIdentical
+	r     rune
+}
+
+// levelIdentical is the level of the relation "=".
+const levelIdentical = Senary + 1
+
+// ParseRules parses tailoring rules in a syntax similar to ICU.
+//
+// A reset "& X" selects the character X in the collation table, and the following relations put characters after it.
+// X must be in the default collation table, be a kanji, or be put by a preceding relation in the same rules.
+// The rules are validated when they are parsed, so the characters added only by [ParseTailoring] can't be X.
+// If X has been put by the rules of a preceding tailoring, the characters are put after its new position.
+// The relation "<" puts a character after the previous one at the level [Primary],
+// "<<" at the level [Secondary], and so on up to "<<<<<<" at the level [Senary].
+// The relation "=" makes a character identical to the previous one.
+// For example, "& あ < ゟ" sorts ゟ after あ and before い,
+// "& Z < Ω" sorts Ω after Z, and "& か << ゕ" sorts ゕ after か and before が.
+//
+// Characters are separated by white spaces, and a syntax character can be quoted as '<'.
+// A "#" starts a comment that continues to the end of the line.
+// Each character in the rules must be a single rune.
+// If the rules have an error, it returns a [*RuleError] that reports the position of the error.
+func ParseRules(rules string) (*Tailoring, error) {
+	var list []rule
+	defined := map[rune]bool{}
+	hasReset := false
+	for i := 0; i < len(rules); {
+		r, n := utf8.DecodeRuneInString(rules[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += n
+			continue
+		case r == '#':
+			for i < len(rules) && rules[i] != '\n' {
+				i++
+			}
+			continue
+		}
+
+		start := i
+		var op rule
+		switch r {
+		case '&':
+			op.reset = true
+			i += n
+		case '<':
+			for i < len(rules) && rules[i] == '<' {
+				i++
+				op.level++
+			}
+			if op.level > Senary {
+				return nil, &RuleError{Offset: start, Msg: fmt.Sprintf("too many '<': %d", op.level)}
+			}
+			if !hasReset {
+				return nil, &RuleError{Offset: start, Msg: "relation without reset"}
+			}
+		case '=':
+			op.level = levelIdentical
+			i += n
+			if !hasReset {
+				return nil, &RuleError{Offset: start, Msg: "relation without reset"}
+			}
+		default:
+			return nil, &RuleError{Offset: start, Msg: fmt.Sprintf("unexpected character %q, want '&', '<' or '='", r)}
+		}
+
+		c, pos, next, err := parseRuleChar(rules, i)
+		if err != nil {
+			return nil, err
+		}
+		i = next
+		op.r = c
+		if op.reset {
+			hasReset = true
+			if _, ok := lookup(c); !ok && !isKanji(c) && !defined[c] {
+				return nil, &RuleError{Offset: pos, Msg: fmt.Sprintf("%q is not in the collation table", c)}
+			}
+		} else {
+			defined[c] = true
+		}
+		list = append(list, op)
+	}
+	return &Tailoring{rules: list}, nil
+}
+
+// parseRuleChar parses a character of the rules at i.
+// It returns the character, its position and the position after it.
+func parseRuleChar(rules string, i int) (r rune, pos, next int, err error) {
+	for i < len(rules) {
+		r, n := utf8.DecodeRuneInString(rules[i:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		i += n
+	}
+	if i >= len(rules) {
+		return 0, i, i, &RuleError{Offset: i, Msg: "missing character"}
+	}
+
+	pos = i
+	r, n := utf8.DecodeRuneInString(rules[i:])
+	if r == '\'' {
+		// quoted character
+		i += n
+		r, n = utf8.DecodeRuneInString(rules[i:])
+		if i >= len(rules) || r == utf8.RuneError && n <= 1 {
+			return 0, pos, i, &RuleError{Offset: pos, Msg: "missing quoted character"}
+		}
+		i += n
+		if i >= len(rules) || rules[i] != '\'' {
+			return 0, pos, i, &RuleError{Offset: pos, Msg: "unterminated quote"}
+		}
+		return r, pos, i + 1, nil
+	}
+	switch r {
+	case '&', '<', '=', '#':
+		return 0, pos, i, &RuleError{Offset: pos, Msg: fmt.Sprintf("missing character before %q", r)}
+	}
+	if r == utf8.RuneError && n <= 1 {
+		return 0, pos, i, &RuleError{Offset: pos, Msg: "invalid UTF-8"}
+	}
+	i += n
+	if i < len(rules) {
+		next, _ := utf8.DecodeRuneInString(rules[i:])
+		if !unicode.IsSpace(next) && next != '&' && next != '<' && next != '=' && next != '#' {
+			return 0, pos, i, &RuleError{Offset: pos, Msg: "a character must be a single rune"}
+		}
+	}
+	return r, pos, i, nil
+}
+
+// ruleList is the list of the characters inserted after an anchor character.
+type ruleList struct {
+	base  attr
+	items []ruleItem
+}
+
+type ruleItem struct {
+	r     rune
+	level Strength
+}
+
+// ruleLists are the characters inserted by the tailoring rules.
+// They are kept in the collator, so that the rules of the following tailorings are merged with them.
+type ruleLists struct {
+	lists    []*ruleList
+	anchors  map[rune]*ruleList
+	inserted map[rune]*ruleList
+}
+
+// remove removes the inserted character r.
+func (rl *ruleLists) remove(r rune) {
+	if l, ok := rl.inserted[r]; ok {
+		idx := l.index(r)
+		l.items = append(l.items[:idx], l.items[idx+1:]...)
+		delete(rl.inserted, r)
+	}
+}
+
+// applyRules inserts the characters into the collation table according to the rules.
+func (c *Collator) applyRules(rules []rule) {
+	rl := c.rules
+	if rl == nil {
+		rl = &ruleLists{
+			anchors:  map[rune]*ruleList{},
+			inserted: map[rune]*ruleList{},
+		}
+		c.rules = rl
+	}
+
+	var cur *ruleList
+	curIdx := -1 // the index of the current item in cur, or -1 for the anchor
+	for _, op := range rules {
+		if op.reset {
+			if l, ok := rl.inserted[op.r]; ok {
+				cur, curIdx = l, l.index(op.r)
+				continue
+			}
+			l, ok := rl.anchors[op.r]
+			if !ok {
+				l = &ruleList{base: c.anchorAttr(op.r)}
+				rl.anchors[op.r] = l
+				rl.lists = append(rl.lists, l)
+			}
+			cur, curIdx = l, -1
+			continue
+		}
+
+		// remove the character from the previous position.
+		if l, ok := rl.inserted[op.r]; ok {
+			idx := l.index(op.r)
+			rl.remove(op.r)
+			if l == cur && idx <= curIdx {
+				curIdx--
+			}
+		}
+
+		// the characters after the current one at the lower levels stay after it.
+		idx := curIdx + 1
+		for idx < len(cur.items) && cur.items[idx].level > op.level {
+			idx++
+		}
+		cur.items = append(cur.items, ruleItem{})
+		copy(cur.items[idx+1:], cur.items[idx:])
+		cur.items[idx] = ruleItem{r: op.r, level: op.level}
+		rl.inserted[op.r] = cur
+		curIdx = idx
+	}
+
+	// the sub-ranks are assigned to all inserted characters,
+	// including the ones inserted by the preceding tailorings.
+	table := make(map[rune]attr, len(c.table)+len(rl.inserted))
+	for r, a := range c.table {
+		table[r] = a
+	}
+	for _, l := range rl.lists {
+		var sub subRanks
+		if l.base.sub != nil {
+			sub = *l.base.sub
+		}
+		for _, item := range l.items {
+			if item.level <= Senary {
+				sub[item.level]++
+				for level := item.level + 1; level <= Senary; level++ {
+					sub[level] = 0
+				}
+			}
+			a := l.base
+			s := sub
+			a.sub = &s
+			table[item.r] = a
+		}
+	}
+	c.table = table
+	c.subRanks = true
+}
+
+// index returns the index of r in the list.
+func (l *ruleList) index(r rune) int {
+	for i, item := range l.items {
+		if item.r == r {
+			return i
+		}
+	}
+	return -1
+}
+
+// anchorAttr returns the attribute of the anchor character r of the rules.
+func (c *Collator) anchorAttr(r rune) attr {
+	if a, ok := c.lookup(r); ok {
+		return a
+	}
+	return attr{
+		class: classKanji,
+		order: c.orderOfKanji(r),
+	}
+}
@@ -0,0 +1,207 @@
+package jisx4061
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testRules is used by the differential tests.
+var testRules = func() *Tailoring {
+	t, err := ParseRules("& あ < ゟ & か << ゕ & a <<<<<< α & Z < Ω")
+	if err != nil {
+		panic(err)
+	}
+	return t
+}()
+
+func TestParseRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules string
+		list  []string
+	}{
+		{
+			name:  "primary",
+			rules: "& あ < ゟ",
+			list:  []string{"あ", "ア", "ゟ", "い"},
+		},
+		{
+			name:  "latin",
+			rules: "& Z < Ω",
+			list:  []string{"y", "z", "Z", "Ω", "あ"},
+		},
+		{
+			name:  "chain",
+			rules: "& a < α < β",
+			list:  []string{"a", "A", "α", "β", "b"},
+		},
+		{
+			name:  "insert before the previous insertion",
+			rules: "& a < β & a < α",
+			list:  []string{"a", "α", "β", "b"},
+		},
+		{
+			name:  "reset to an inserted character",
+			rules: "& a < α & α < β",
+			list:  []string{"a", "α", "β", "b"},
+		},
+		{
+			name:  "secondary",
+			rules: "& か << ゕ",
+			list:  []string{"か", "ゕ", "が", "き"},
+		},
+		{
+			name:  "lower levels stay after the current character",
+			rules: "& a << α & a < β",
+			list:  []string{"a", "α", "β", "b"},
+		},
+		{
+			name:  "senary",
+			rules: "& a <<<<<< α",
+			list:  []string{"a", "α", "A", "b"},
+		},
+		{
+			name:  "kanji",
+			rules: "& 一 < 〡",
+			list:  []string{"一", "〡", "丁"},
+		},
+		{
+			name:  "reorder",
+			rules: "& c < a",
+			list:  []string{"b", "c", "a", "d"},
+		},
+		{
+			name: "comment and quote",
+			rules: "# sort '<' after z\n" +
+				"& z < '<'",
+			list: []string{"z", "<", "あ"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tailoring, err := ParseRules(tt.rules)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := New(WithTailoring(tailoring))
+			for i, a := range tt.list {
+				for j, b := range tt.list {
+					want := compare(i, j)
+					if got := c.Compare(a, b); got != want {
+						t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+					}
+					if got := compareMultiPass(c, a, b); got != want {
+						t.Errorf("compareMultiPass(%q, %q) = %d, want %d", a, b, got, want)
+					}
+					if got := bytes.Compare(c.Key(a), c.Key(b)); got != want {
+						t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", a, b, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestParseRules_Identical(t *testing.T) {
+	tailoring, err := ParseRules("& a = α")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := New(WithTailoring(tailoring))
+	if got := c.Compare("α", "a"); got != 0 {
+		t.Errorf("Compare(%q, %q) = %d, want 0", "α", "a", got)
+	}
+	if !bytes.Equal(c.Key("α"), c.Key("a")) {
+		t.Errorf("want Key(%q) == Key(%q), but not", "α", "a")
+	}
+}
+
+func TestParseRules_Level(t *testing.T) {
+	tailoring, err := ParseRules("& か << ゕ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the secondary insertion is ignored at the primary level.
+	c := New(WithTailoring(tailoring), WithStrength(Primary))
+	if got := c.Compare("ゕ", "か"); got != 0 {
+		t.Errorf("Compare(%q, %q) = %d, want 0", "ゕ", "か", got)
+	}
+}
+
+func TestParseRules_Stacked(t *testing.T) {
+	t1, err := ParseRules("& a < α < β")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t2, err := ParseRules("& α < γ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := New(WithTailoring(t1), WithTailoring(t2))
+	want := []string{"a", "α", "γ", "β", "b"}
+	for i := 0; i < len(want)-1; i++ {
+		if got := c.Compare(want[i], want[i+1]); got != -1 {
+			t.Errorf("Compare(%q, %q) = %d, want -1", want[i], want[i+1], got)
+		}
+	}
+	if got := c.Compare("γ", "β"); got != -1 {
+		t.Errorf("Compare(%q, %q) = %d, want -1", "γ", "β", got)
+	}
+}
+
+func TestParseRules_StackedTable(t *testing.T) {
+	t1, err := ParseRules("& a < α < β")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t2, err := ParseTailoring(strings.NewReader("文字\t文字クラス\t番号\tダイアクリティカルマーク\t大小\t清濁\t記号種別\t仮名種別\n" +
+		"α\t一般記号\t1003\t\t\t\t\t\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the table takes precedence over the preceding rules, and the others stay.
+	c := New(WithTailoring(t1), WithTailoring(t2))
+	if got := c.Compare("α", "a"); got != -1 {
+		t.Errorf("Compare(%q, %q) = %d, want -1", "α", "a", got)
+	}
+	if got := c.Compare("a", "β"); got != -1 {
+		t.Errorf("Compare(%q, %q) = %d, want -1", "a", "β", got)
+	}
+	if got := c.Compare("β", "b"); got != -1 {
+		t.Errorf("Compare(%q, %q) = %d, want -1", "β", "b", got)
+	}
+}
+
+func TestParseRules_Error(t *testing.T) {
+	tests := []struct {
+		rules  string
+		offset int
+	}{
+		{"a < b", 0},
+		{"< b", 0},
+		{"& a <<<<<<< b", 4},
+		{"& a <", 5},
+		{"& a < < b", 6},
+		{"& ab < c", 2},
+		{"& 😀 < a", 2},
+		{"& ✅ < a", 2}, // only in the tailored table
+		{"& a < 'b", 6},
+		{"& a < b ! c", 8},
+		{"& a < b\n& c <", 13},
+	}
+	for _, tt := range tests {
+		_, err := ParseRules(tt.rules)
+		var e *RuleError
+		if !errors.As(err, &e) {
+			t.Errorf("ParseRules(%q) = %v, want *RuleError", tt.rules, err)
+			continue
+		}
+		if e.Offset != tt.offset {
+			t.Errorf("ParseRules(%q): offset = %d, want %d: %v", tt.rules, e.Offset, tt.offset, e)
+		}
+	}
+}
@@ -28,3 +28,82 @@ func Stable(s []string) {
 func IsSorted(data []string) bool {
 	return sort.IsSorted(StringSlice(data))
 }
+
+// collatorSlice implements [sort.Interface] with a [Collator].
+type collatorSlice struct {
+	c *Collator
+	s []string
+}
+
+func (s collatorSlice) Len() int           { return len(s.s) }
+func (s collatorSlice) Swap(i, j int)      { s.s[i], s.s[j] = s.s[j], s.s[i] }
+func (s collatorSlice) Less(i, j int) bool { return s.c.Less(s.s[i], s.s[j]) }
+
+// Sort sorts s with the collator.
+func (c *Collator) Sort(s []string) {
+	sort.Sort(collatorSlice{c: c, s: s})
+}
+
+// Stable sorts s with the collator.
+func (c *Collator) Stable(s []string) {
+	sort.Stable(collatorSlice{c: c, s: s})
+}
+
+// IsSorted reports whether data is sorted with the collator.
+func (c *Collator) IsSorted(data []string) bool {
+	return sort.IsSorted(collatorSlice{c: c, s: data})
+}
+
+// SortByReading sorts items by their readings (読み), and then by their display strings.
+// It is useful for sorting records that have the readings separately, such as 氏名 and フリガナ.
+// The sort is stable.
+func SortByReading[T any](items []T, display func(T) string, reading func(T) string) {
+	sortStableFunc(items, func(a, b T) int {
+		return compareByReading(a, b, display, reading)
+	})
+}
+
+func compareByReading[T any](a, b T, display func(T) string, reading func(T) string) int {
+	if ret := Compare(reading(a), reading(b)); ret != 0 {
+		return ret
+	}
+	return Compare(display(a), display(b))
+}
+
+// SortFunc sorts s by the strings that key returns.
+// The sort is not guaranteed to be stable.
+func SortFunc[T any](s []T, key func(T) string) {
+	sortFunc(s, func(a, b T) int {
+		return Compare(key(a), key(b))
+	})
+}
+
+// SortStableFunc sorts s by the strings that key returns, while keeping the original order of equal elements.
+func SortStableFunc[T any](s []T, key func(T) string) {
+	sortStableFunc(s, func(a, b T) int {
+		return Compare(key(a), key(b))
+	})
+}
+
+// IsSortedFunc reports whether s is sorted by the strings that key returns.
+func IsSortedFunc[T any](s []T, key func(T) string) bool {
+	return isSortedFunc(s, func(a, b T) int {
+		return Compare(key(a), key(b))
+	})
+}
+
+// BinarySearch searches for target in a sorted slice x and returns the position where target is found,
+// or the position where target would appear in the sort order.
+// It also returns a bool saying whether the target is really found in the slice.
+// x must be sorted by [Sort].
+func BinarySearch(x []string, target string) (int, bool) {
+	return binarySearchFunc(x, target, Compare)
+}
+
+// BinarySearchFunc works like [BinarySearch], but searches the strings that key returns.
+// x must be sorted by [SortFunc] with the same key.
+func BinarySearchFunc[T any](x []T, target string, key func(T) string) (int, bool) {
+	return binarySearchFunc(x, target, func(e T, target string) int {
+		return Compare(key(e), target)
+	})
+}
@@ -0,0 +1,30 @@
+//go:build !go1.21
+
+package jisx4061
+
+import "sort"
+
+func sortFunc[T any](s []T, cmp func(a, b T) int) {
+	sort.Slice(s, func(i, j int) bool {
+		return cmp(s[i], s[j]) < 0
+	})
+}
+
+func sortStableFunc[T any](s []T, cmp func(a, b T) int) {
+	sort.SliceStable(s, func(i, j int) bool {
+		return cmp(s[i], s[j]) < 0
+	})
+}
+
+func isSortedFunc[T any](s []T, cmp func(a, b T) int) bool {
+	return sort.SliceIsSorted(s, func(i, j int) bool {
+		return cmp(s[i], s[j]) < 0
+	})
+}
+
+func binarySearchFunc[E, T any](x []E, target T, cmp func(E, T) int) (int, bool) {
+	i := sort.Search(len(x), func(i int) bool {
+		return cmp(x[i], target) >= 0
+	})
+	return i, i < len(x) && cmp(x[i], target) == 0
+}
@@ -0,0 +1,21 @@
+//go:build go1.21
+
+package jisx4061
+
+import "slices"
+
+func sortFunc[T any](s []T, cmp func(a, b T) int) {
+	slices.SortFunc(s, cmp)
+}
+
+func sortStableFunc[T any](s []T, cmp func(a, b T) int) {
+	slices.SortStableFunc(s, cmp)
+}
+
+func isSortedFunc[T any](s []T, cmp func(a, b T) int) bool {
+	return slices.IsSortedFunc(s, cmp)
+}
+
+func binarySearchFunc[E, T any](x []E, target T, cmp func(E, T) int) (int, bool) {
+	return slices.BinarySearchFunc(x, target, cmp)
+}
@@ -0,0 +1,134 @@
+package jisx4061
+
+import (
+	"testing"
+)
+
+func TestSortByReading(t *testing.T) {
+	type person struct {
+		name     string
+		furigana string
+	}
+	people := []person{
+		{"斎藤", "サイトウ"},
+		{"佐藤", "サトウ"},
+		{"斉藤", "サイトウ"},
+		{"加藤", "カトウ"},
+		{"佐東", "サトウ"},
+		{"齋藤", "サイトウ"},
+		{"佐藤", "さとう"},
+	}
+	SortByReading(people, func(p person) string { return p.name }, func(p person) string { return p.furigana })
+	want := []person{
+		{"加藤", "カトウ"},
+		{"斉藤", "サイトウ"},
+		{"斎藤", "サイトウ"},
+		{"齋藤", "サイトウ"},
+		{"佐藤", "さとう"},
+		{"佐東", "サトウ"},
+		{"佐藤", "サトウ"},
+	}
+	for i := range want {
+		if people[i] != want[i] {
+			t.Errorf("want %v, got %v", want, people)
+			break
+		}
+	}
+}
+
+type product struct {
+	name string
+	id   int
+}
+
+func productName(p product) string { return p.name }
+
+func TestSortFunc(t *testing.T) {
+	products := []product{
+		{"さとうや", 1},
+		{"さど", 2},
+		{"さとう", 3},
+		{"さと", 4},
+	}
+	SortFunc(products, productName)
+	want := []string{"さと", "さど", "さとう", "さとうや"}
+	for i := range want {
+		if products[i].name != want[i] {
+			t.Errorf("want %v, got %v", want, products)
+			break
+		}
+	}
+	if !IsSortedFunc(products, productName) {
+		t.Errorf("want %v is sorted, but not", products)
+	}
+}
+
+func TestSortStableFunc(t *testing.T) {
+	products := []product{
+		{"ｂ", 1},
+		{"a", 2},
+		{"b", 3},
+		{"ａ", 4},
+	}
+	SortStableFunc(products, productName)
+	want := []product{
+		{"a", 2},
+		{"ａ", 4},
+		{"ｂ", 1},
+		{"b", 3},
+	}
+	for i := range want {
+		if products[i] != want[i] {
+			t.Errorf("want %v, got %v", want, products)
+			break
+		}
+	}
+}
+
+func TestIsSortedFunc(t *testing.T) {
+	products := []product{
+		{"さど", 1},
+		{"さと", 2},
+	}
+	if IsSortedFunc(products, productName) {
+		t.Errorf("want %v is not sorted, but it is", products)
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	list := []string{"さと", "さど", "さとう", "さどう", "さとうや", "サトー", "さとおや"}
+	tests := []struct {
+		target string
+		pos    int
+		found  bool
+	}{
+		{"さ", 0, false},
+		{"さと", 0, true},
+		{"さとう", 2, true},
+		{"サトウ", 3, false},
+		{"さとおや", 6, true},
+		{"さとおやま", 7, false},
+	}
+	for _, tt := range tests {
+		pos, found := BinarySearch(list, tt.target)
+		if pos != tt.pos || found != tt.found {
+			t.Errorf("BinarySearch(%q) = %d, %t, want %d, %t", tt.target, pos, found, tt.pos, tt.found)
+		}
+	}
+}
+
+func TestBinarySearchFunc(t *testing.T) {
+	products := []product{
+		{"さと", 1},
+		{"さど", 2},
+		{"さとう", 3},
+	}
+	pos, found := BinarySearchFunc(products, "さど", productName)
+	if pos != 1 || !found {
+		t.Errorf("BinarySearchFunc(%q) = %d, %t, want %d, %t", "さど", pos, found, 1, true)
+	}
+	pos, found = BinarySearchFunc(products, "さどう", productName)
+	if pos != 3 || found {
+		t.Errorf("BinarySearchFunc(%q) = %d, %t, want %d, %t", "さどう", pos, found, 3, false)
+	}
+}
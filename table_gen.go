@@ -2,3061 +2,3397 @@
 
 package jisx4061
 
-var table = map[rune]attr{
-	' ': {
+const tableBlockBits = 6
+const tableMax = 0x10000
+
+var tableIndex = [1024]uint8{
+	1, 2, 3, 4, 5, 6, 0, 0, 0, 0, 0, 0, 0, 0, 7, 8,
+	9, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	11, 0, 0, 0, 12, 0, 13, 14, 15, 16, 17, 0, 18, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 19, 20, 21, 22, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	23, 24, 25, 26, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 27, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 28, 29, 0, 30,
+}
+
+var tableBlocks = [1984]uint16{
+	// block 0
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 1
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1, 0, 0, 2, 3, 4, 5, 0, 0, 0, 6, 0, 0, 0, 0, 0,
+	7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 0, 0, 0, 0, 0, 0,
+	// block 2
+	17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32,
+	33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 0, 0, 0, 0, 0,
+	0, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58,
+	59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 0, 0, 0, 0, 0,
+	// block 3
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 70, 0, 71, 0, 0, 0, 0, 0, 0, 0, 0,
+	72, 73, 0, 0, 0, 0, 74, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 4
+	0, 0, 75, 0, 0, 76, 0, 0, 0, 0, 77, 0, 0, 0, 78, 0,
+	0, 0, 0, 0, 79, 0, 0, 80, 0, 0, 0, 81, 0, 0, 0, 0,
+	0, 0, 82, 0, 0, 0, 0, 0, 0, 0, 83, 0, 0, 0, 84, 0,
+	0, 0, 0, 0, 85, 0, 0, 86, 0, 0, 0, 87, 0, 0, 0, 0,
+	// block 5
+	88, 89, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 90, 91, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 93, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 6
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 94, 95, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 96, 97, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 7
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 0, 115, 116, 117, 118, 119, 120, 121, 0, 0, 0, 0, 0, 0,
+	0, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	// block 8
+	137, 138, 0, 139, 140, 141, 142, 143, 144, 145, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 9
+	0, 146, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	147, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162,
+	163, 164, 165, 166, 167, 168, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
+	// block 10
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	0, 211, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 11
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	212, 0, 0, 0, 0, 213, 0, 0, 214, 215, 0, 0, 216, 217, 0, 0,
+	218, 219, 0, 0, 0, 220, 221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	222, 0, 223, 224, 0, 0, 0, 0, 0, 0, 0, 225, 0, 0, 0, 0,
+	// block 12
+	0, 0, 0, 226, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 13
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	227, 228, 229, 230, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 14
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 231, 0, 232, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 15
+	233, 0, 234, 235, 0, 0, 0, 236, 237, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 239, 0, 0, 240, 241, 0,
+	242, 0, 0, 0, 0, 243, 0, 244, 245, 246, 247, 248, 249, 0, 0, 0,
+	0, 0, 0, 0, 250, 251, 0, 0, 0, 0, 0, 0, 0, 252, 0, 0,
+	// block 16
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 253, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	254, 255, 0, 0, 0, 0, 256, 257, 0, 0, 258, 259, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 17
+	0, 0, 260, 261, 0, 0, 262, 263, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 264, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 18
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 265, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 19
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 267, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 268, 269, 0, 0, 0, 0, 0, 0, 0, 0, 270, 271, 0, 0,
+	// block 20
+	0, 0, 0, 0, 0, 0, 272, 273, 0, 0, 0, 274, 0, 0, 275, 276,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 21
+	0, 0, 0, 0, 0, 277, 278, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 22
+	279, 0, 280, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 281, 0, 0, 282, 0, 283,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 23
+	1, 284, 285, 286, 0, 287, 288, 289, 290, 291, 292, 293, 294, 295, 296, 297,
+	298, 299, 300, 301, 302, 303, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 24
+	0, 304, 305, 306, 307, 308, 309, 310, 311, 312, 313, 314, 315, 316, 317, 318,
+	319, 320, 321, 322, 323, 324, 325, 326, 327, 328, 329, 330, 331, 332, 333, 334,
+	335, 336, 337, 338, 339, 340, 341, 342, 343, 344, 345, 346, 347, 348, 349, 350,
+	351, 352, 353, 354, 355, 356, 357, 358, 359, 360, 361, 362, 363, 364, 365, 366,
+	// block 25
+	367, 368, 369, 370, 371, 372, 373, 374, 375, 376, 377, 378, 379, 380, 381, 382,
+	383, 384, 385, 386, 387, 0, 0, 0, 0, 0, 0, 0, 0, 388, 389, 0,
+	0, 390, 391, 392, 393, 394, 395, 396, 397, 398, 399, 400, 401, 402, 403, 404,
+	405, 406, 407, 408, 409, 410, 411, 412, 413, 414, 415, 416, 417, 418, 419, 420,
+	// block 26
+	421, 422, 423, 424, 425, 426, 427, 428, 429, 430, 431, 432, 433, 434, 435, 436,
+	437, 438, 439, 440, 441, 442, 443, 444, 445, 446, 447, 448, 449, 450, 451, 452,
+	453, 454, 455, 456, 457, 458, 459, 460, 461, 462, 463, 464, 465, 466, 467, 468,
+	469, 470, 471, 472, 473, 0, 0, 474, 475, 476, 477, 478, 479, 480, 481, 0,
+	// block 27
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 482, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 28
+	0, 483, 0, 2, 3, 4, 5, 0, 484, 485, 6, 486, 487, 488, 489, 490,
+	7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 491, 492, 493, 494, 495, 496,
+	17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32,
+	33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 497, 498, 499, 0, 500,
+	// block 29
+	0, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58,
+	59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 501, 502, 503, 504, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	// block 30
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	505, 506, 507, 508, 0, 70, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+}
+
+var tableEntries = [509]attr{
+	{},
+	// " \u3000"
+	{
 		class: classSpace,
 		order: 1,
 	},
-	'　': {
-		class: classSpace,
+	// "#＃"
+	{
+		class: classGeneral,
 		order: 1,
 	},
-	'、': {
-		class: classDescriptor,
+	// "$＄"
+	{
+		class: classUnit,
+		order: 6,
+	},
+	// "%％"
+	{
+		class: classUnit,
+		order: 9,
+	},
+	// "&＆"
+	{
+		class: classGeneral,
+		order: 2,
+	},
+	// "*＊"
+	{
+		class: classGeneral,
+		order: 4,
+	},
+	// "0０"
+	{
+		class: classNumber,
 		order: 1,
 	},
-	'。': {
-		class: classDescriptor,
+	// "1１"
+	{
+		class: classNumber,
 		order: 2,
 	},
-	'，': {
-		class: classDescriptor,
+	// "2２"
+	{
+		class: classNumber,
 		order: 3,
 	},
-	'．': {
-		class: classDescriptor,
+	// "3３"
+	{
+		class: classNumber,
 		order: 4,
 	},
-	'・': {
-		class: classDescriptor,
+	// "4４"
+	{
+		class: classNumber,
 		order: 5,
 	},
-	'：': {
-		class: classDescriptor,
+	// "5５"
+	{
+		class: classNumber,
 		order: 6,
 	},
-	'；': {
-		class: classDescriptor,
+	// "6６"
+	{
+		class: classNumber,
 		order: 7,
 	},
-	'？': {
-		class: classDescriptor,
+	// "7７"
+	{
+		class: classNumber,
 		order: 8,
 	},
-	'！': {
-		class: classDescriptor,
+	// "8８"
+	{
+		class: classNumber,
 		order: 9,
 	},
-	'￣': {
-		class: classDescriptor,
+	// "9９"
+	{
+		class: classNumber,
 		order: 10,
 	},
-	'＿': {
-		class: classDescriptor,
-		order: 11,
-	},
-	'―': {
-		class: classDescriptor,
-		order: 12,
+	// "@＠"
+	{
+		class: classGeneral,
+		order: 3,
 	},
-	'‐': {
-		class: classDescriptor,
-		order: 13,
+	// "AＡ"
+	{
+		class:      classAlphabet,
+		order:      1,
+		letterCase: letterCaseUpper,
 	},
-	'／': {
-		class: classDescriptor,
-		order: 14,
+	// "BＢ"
+	{
+		class:      classAlphabet,
+		order:      2,
+		letterCase: letterCaseUpper,
 	},
-	'＼': {
-		class: classDescriptor,
-		order: 15,
+	// "CＣ"
+	{
+		class:      classAlphabet,
+		order:      3,
+		letterCase: letterCaseUpper,
 	},
-	'～': {
-		class: classDescriptor,
-		order: 16,
+	// "DＤ"
+	{
+		class:      classAlphabet,
+		order:      4,
+		letterCase: letterCaseUpper,
 	},
-	'∥': {
-		class: classDescriptor,
-		order: 17,
+	// "EＥ"
+	{
+		class:      classAlphabet,
+		order:      5,
+		letterCase: letterCaseUpper,
 	},
-	'｜': {
-		class: classDescriptor,
-		order: 18,
+	// "FＦ"
+	{
+		class:      classAlphabet,
+		order:      6,
+		letterCase: letterCaseUpper,
 	},
-	'…': {
-		class: classDescriptor,
-		order: 19,
+	// "GＧ"
+	{
+		class:      classAlphabet,
+		order:      7,
+		letterCase: letterCaseUpper,
 	},
-	'‥': {
-		class: classDescriptor,
-		order: 20,
+	// "HＨ"
+	{
+		class:      classAlphabet,
+		order:      8,
+		letterCase: letterCaseUpper,
 	},
-	'‘': {
-		class: classBracket,
-		order: 1,
+	// "IＩ"
+	{
+		class:      classAlphabet,
+		order:      9,
+		letterCase: letterCaseUpper,
 	},
-	'’': {
-		class: classBracket,
-		order: 2,
+	// "JＪ"
+	{
+		class:      classAlphabet,
+		order:      10,
+		letterCase: letterCaseUpper,
 	},
-	'“': {
-		class: classBracket,
-		order: 3,
+	// "KＫ"
+	{
+		class:      classAlphabet,
+		order:      11,
+		letterCase: letterCaseUpper,
 	},
-	'”': {
-		class: classBracket,
-		order: 4,
+	// "LＬ"
+	{
+		class:      classAlphabet,
+		order:      12,
+		letterCase: letterCaseUpper,
 	},
-	'（': {
-		class: classBracket,
-		order: 5,
+	// "MＭ"
+	{
+		class:      classAlphabet,
+		order:      13,
+		letterCase: letterCaseUpper,
 	},
-	'）': {
-		class: classBracket,
-		order: 6,
+	// "NＮ"
+	{
+		class:      classAlphabet,
+		order:      14,
+		letterCase: letterCaseUpper,
 	},
-	'〔': {
-		class: classBracket,
-		order: 7,
+	// "OＯ"
+	{
+		class:      classAlphabet,
+		order:      15,
+		letterCase: letterCaseUpper,
 	},
-	'〕': {
-		class: classBracket,
-		order: 8,
+	// "PＰ"
+	{
+		class:      classAlphabet,
+		order:      16,
+		letterCase: letterCaseUpper,
 	},
-	'［': {
-		class: classBracket,
-		order: 9,
+	// "QＱ"
+	{
+		class:      classAlphabet,
+		order:      17,
+		letterCase: letterCaseUpper,
 	},
-	'］': {
-		class: classBracket,
-		order: 10,
+	// "RＲ"
+	{
+		class:      classAlphabet,
+		order:      18,
+		letterCase: letterCaseUpper,
 	},
-	'｛': {
-		class: classBracket,
-		order: 11,
+	// "SＳ"
+	{
+		class:      classAlphabet,
+		order:      19,
+		letterCase: letterCaseUpper,
 	},
-	'｝': {
-		class: classBracket,
-		order: 12,
+	// "TＴ"
+	{
+		class:      classAlphabet,
+		order:      20,
+		letterCase: letterCaseUpper,
 	},
-	'〈': {
-		class: classBracket,
-		order: 13,
+	// "UＵ"
+	{
+		class:      classAlphabet,
+		order:      21,
+		letterCase: letterCaseUpper,
 	},
-	'〉': {
-		class: classBracket,
-		order: 14,
+	// "VＶ"
+	{
+		class:      classAlphabet,
+		order:      22,
+		letterCase: letterCaseUpper,
 	},
-	'《': {
-		class: classBracket,
-		order: 15,
+	// "WＷ"
+	{
+		class:      classAlphabet,
+		order:      23,
+		letterCase: letterCaseUpper,
 	},
-	'》': {
-		class: classBracket,
-		order: 16,
+	// "XＸ"
+	{
+		class:      classAlphabet,
+		order:      24,
+		letterCase: letterCaseUpper,
 	},
-	'「': {
-		class: classBracket,
-		order: 17,
+	// "YＹ"
+	{
+		class:      classAlphabet,
+		order:      25,
+		letterCase: letterCaseUpper,
 	},
-	'」': {
-		class: classBracket,
-		order: 18,
+	// "ZＺ"
+	{
+		class:      classAlphabet,
+		order:      26,
+		letterCase: letterCaseUpper,
 	},
-	'『': {
-		class: classBracket,
-		order: 19,
+	// "aａ"
+	{
+		class:      classAlphabet,
+		order:      1,
+		letterCase: letterCaseLower,
 	},
-	'』': {
-		class: classBracket,
-		order: 20,
+	// "bｂ"
+	{
+		class:      classAlphabet,
+		order:      2,
+		letterCase: letterCaseLower,
 	},
-	'【': {
-		class: classBracket,
-		order: 21,
+	// "cｃ"
+	{
+		class:      classAlphabet,
+		order:      3,
+		letterCase: letterCaseLower,
 	},
-	'】': {
-		class: classBracket,
-		order: 22,
+	// "dｄ"
+	{
+		class:      classAlphabet,
+		order:      4,
+		letterCase: letterCaseLower,
 	},
-	'＋': {
-		class: classScience,
-		order: 1,
+	// "eｅ"
+	{
+		class:      classAlphabet,
+		order:      5,
+		letterCase: letterCaseLower,
 	},
-	'－': {
-		class: classScience,
-		order: 2,
+	// "fｆ"
+	{
+		class:      classAlphabet,
+		order:      6,
+		letterCase: letterCaseLower,
 	},
-	'±': {
-		class: classScience,
-		order: 3,
+	// "gｇ"
+	{
+		class:      classAlphabet,
+		order:      7,
+		letterCase: letterCaseLower,
 	},
-	'×': {
-		class: classScience,
-		order: 4,
+	// "hｈ"
+	{
+		class:      classAlphabet,
+		order:      8,
+		letterCase: letterCaseLower,
 	},
-	'÷': {
-		class: classScience,
-		order: 5,
+	// "iｉ"
+	{
+		class:      classAlphabet,
+		order:      9,
+		letterCase: letterCaseLower,
 	},
-	'＝': {
-		class: classScience,
-		order: 6,
+	// "jｊ"
+	{
+		class:      classAlphabet,
+		order:      10,
+		letterCase: letterCaseLower,
 	},
-	'≠': {
-		class: classScience,
-		order: 7,
+	// "kｋ"
+	{
+		class:      classAlphabet,
+		order:      11,
+		letterCase: letterCaseLower,
 	},
-	'＜': {
-		class: classScience,
-		order: 8,
+	// "lｌ"
+	{
+		class:      classAlphabet,
+		order:      12,
+		letterCase: letterCaseLower,
 	},
-	'＞': {
-		class: classScience,
-		order: 9,
+	// "mｍ"
+	{
+		class:      classAlphabet,
+		order:      13,
+		letterCase: letterCaseLower,
 	},
-	'≦': {
-		class: classScience,
-		order: 10,
+	// "nｎ"
+	{
+		class:      classAlphabet,
+		order:      14,
+		letterCase: letterCaseLower,
 	},
-	'≧': {
-		class: classScience,
-		order: 11,
+	// "oｏ"
+	{
+		class:      classAlphabet,
+		order:      15,
+		letterCase: letterCaseLower,
 	},
-	'≒': {
-		class: classScience,
-		order: 12,
+	// "pｐ"
+	{
+		class:      classAlphabet,
+		order:      16,
+		letterCase: letterCaseLower,
 	},
-	'≪': {
-		class: classScience,
-		order: 13,
+	// "qｑ"
+	{
+		class:      classAlphabet,
+		order:      17,
+		letterCase: letterCaseLower,
 	},
-	'≫': {
-		class: classScience,
-		order: 14,
+	// "rｒ"
+	{
+		class:      classAlphabet,
+		order:      18,
+		letterCase: letterCaseLower,
 	},
-	'∝': {
-		class: classScience,
-		order: 15,
+	// "sｓ"
+	{
+		class:      classAlphabet,
+		order:      19,
+		letterCase: letterCaseLower,
 	},
-	'∞': {
-		class: classScience,
-		order: 16,
+	// "tｔ"
+	{
+		class:      classAlphabet,
+		order:      20,
+		letterCase: letterCaseLower,
 	},
-	'∂': {
-		class: classScience,
-		order: 17,
+	// "uｕ"
+	{
+		class:      classAlphabet,
+		order:      21,
+		letterCase: letterCaseLower,
 	},
-	'∇': {
-		class: classScience,
-		order: 18,
+	// "vｖ"
+	{
+		class:      classAlphabet,
+		order:      22,
+		letterCase: letterCaseLower,
 	},
-	'√': {
-		class: classScience,
-		order: 19,
+	// "wｗ"
+	{
+		class:      classAlphabet,
+		order:      23,
+		letterCase: letterCaseLower,
 	},
-	'∫': {
-		class: classScience,
-		order: 20,
+	// "xｘ"
+	{
+		class:      classAlphabet,
+		order:      24,
+		letterCase: letterCaseLower,
 	},
-	'∬': {
-		class: classScience,
-		order: 21,
+	// "yｙ"
+	{
+		class:      classAlphabet,
+		order:      25,
+		letterCase: letterCaseLower,
 	},
-	'∠': {
-		class: classScience,
-		order: 22,
+	// "zｚ"
+	{
+		class:      classAlphabet,
+		order:      26,
+		letterCase: letterCaseLower,
 	},
-	'⊥': {
-		class: classScience,
-		order: 23,
+	// "¥￥"
+	{
+		class: classUnit,
+		order: 5,
 	},
-	'⌒': {
-		class: classScience,
-		order: 24,
+	// "§"
+	{
+		class: classGeneral,
+		order: 5,
 	},
-	'≡': {
-		class: classScience,
-		order: 25,
+	// "°"
+	{
+		class: classUnit,
+		order: 1,
 	},
-	'∽': {
+	// "±"
+	{
 		class: classScience,
-		order: 26,
+		order: 3,
 	},
-	'∈': {
-		class: classScience,
-		order: 27,
+	// "¶"
+	{
+		class: classGeneral,
+		order: 6,
 	},
-	'∋': {
-		class: classScience,
-		order: 28,
+	// "Â"
+	{
+		class:           classAlphabet,
+		order:           1,
+		diacriticalMark: diacriticalMarkCircumflexAccent,
+		letterCase:      letterCaseUpper,
 	},
-	'⊆': {
-		class: classScience,
-		order: 29,
+	// "Å"
+	{
+		class: classUnit,
+		order: 11,
 	},
-	'⊇': {
-		class: classScience,
-		order: 30,
+	// "Ê"
+	{
+		class:           classAlphabet,
+		order:           5,
+		diacriticalMark: diacriticalMarkCircumflexAccent,
+		letterCase:      letterCaseUpper,
 	},
-	'⊂': {
-		class: classScience,
-		order: 31,
+	// "Î"
+	{
+		class:           classAlphabet,
+		order:           9,
+		diacriticalMark: diacriticalMarkCircumflexAccent,
+		letterCase:      letterCaseUpper,
 	},
-	'⊃': {
-		class: classScience,
-		order: 32,
+	// "Ô"
+	{
+		class:           classAlphabet,
+		order:           15,
+		diacriticalMark: diacriticalMarkCircumflexAccent,
+		letterCase:      letterCaseUpper,
 	},
-	'∪': {
+	// "×"
+	{
 		class: classScience,
-		order: 33,
+		order: 4,
 	},
-	'∩': {
-		class: classScience,
-		order: 34,
+	// "Û"
+	{
+		class:           classAlphabet,
+		order:           21,
+		diacriticalMark: diacriticalMarkCircumflexAccent,
+		letterCase:      letterCaseUpper,
 	},
-	'∧': {
+	// "â"
+	{
+		class:           classAlphabet,
+		order:           1,
+		diacriticalMark: diacriticalMarkCircumflexAccent,
+		letterCase:      letterCaseLower,
+	},
+	// "ê"
+	{
+		class:           classAlphabet,
+		order:           5,
+		diacriticalMark: diacriticalMarkCircumflexAccent,
+		letterCase:      letterCaseLower,
+	},
+	// "î"
+	{
+		class:           classAlphabet,
+		order:           9,
+		diacriticalMark: diacriticalMarkCircumflexAccent,
+		letterCase:      letterCaseLower,
+	},
+	// "ô"
+	{
+		class:           classAlphabet,
+		order:           15,
+		diacriticalMark: diacriticalMarkCircumflexAccent,
+		letterCase:      letterCaseLower,
+	},
+	// "÷"
+	{
 		class: classScience,
+		order: 5,
+	},
+	// "û"
+	{
+		class:           classAlphabet,
+		order:           21,
+		diacriticalMark: diacriticalMarkCircumflexAccent,
+		letterCase:      letterCaseLower,
+	},
+	// "Ā"
+	{
+		class:           classAlphabet,
+		order:           1,
+		diacriticalMark: diacriticalMarkMacron,
+		letterCase:      letterCaseUpper,
+	},
+	// "ā"
+	{
+		class:           classAlphabet,
+		order:           1,
+		diacriticalMark: diacriticalMarkMacron,
+		letterCase:      letterCaseLower,
+	},
+	// "Ē"
+	{
+		class:           classAlphabet,
+		order:           5,
+		diacriticalMark: diacriticalMarkMacron,
+		letterCase:      letterCaseUpper,
+	},
+	// "ē"
+	{
+		class:           classAlphabet,
+		order:           5,
+		diacriticalMark: diacriticalMarkMacron,
+		letterCase:      letterCaseLower,
+	},
+	// "Ī"
+	{
+		class:           classAlphabet,
+		order:           9,
+		diacriticalMark: diacriticalMarkMacron,
+		letterCase:      letterCaseUpper,
+	},
+	// "ī"
+	{
+		class:           classAlphabet,
+		order:           9,
+		diacriticalMark: diacriticalMarkMacron,
+		letterCase:      letterCaseLower,
+	},
+	// "Ō"
+	{
+		class:           classAlphabet,
+		order:           15,
+		diacriticalMark: diacriticalMarkMacron,
+		letterCase:      letterCaseUpper,
+	},
+	// "ō"
+	{
+		class:           classAlphabet,
+		order:           15,
+		diacriticalMark: diacriticalMarkMacron,
+		letterCase:      letterCaseLower,
+	},
+	// "Ū"
+	{
+		class:           classAlphabet,
+		order:           21,
+		diacriticalMark: diacriticalMarkMacron,
+		letterCase:      letterCaseUpper,
+	},
+	// "ū"
+	{
+		class:           classAlphabet,
+		order:           21,
+		diacriticalMark: diacriticalMarkMacron,
+		letterCase:      letterCaseLower,
+	},
+	// "Α"
+	{
+		class: classSymbol,
+		order: 25,
+	},
+	// "Β"
+	{
+		class: classSymbol,
+		order: 26,
+	},
+	// "Γ"
+	{
+		class: classSymbol,
+		order: 27,
+	},
+	// "Δ"
+	{
+		class: classSymbol,
+		order: 28,
+	},
+	// "Ε"
+	{
+		class: classSymbol,
+		order: 29,
+	},
+	// "Ζ"
+	{
+		class: classSymbol,
+		order: 30,
+	},
+	// "Η"
+	{
+		class: classSymbol,
+		order: 31,
+	},
+	// "Θ"
+	{
+		class: classSymbol,
+		order: 32,
+	},
+	// "Ι"
+	{
+		class: classSymbol,
+		order: 33,
+	},
+	// "Κ"
+	{
+		class: classSymbol,
+		order: 34,
+	},
+	// "Λ"
+	{
+		class: classSymbol,
 		order: 35,
 	},
-	'∨': {
-		class: classScience,
+	// "Μ"
+	{
+		class: classSymbol,
 		order: 36,
 	},
-	'￢': {
-		class: classScience,
+	// "Ν"
+	{
+		class: classSymbol,
 		order: 37,
 	},
-	'⇒': {
-		class: classScience,
+	// "Ξ"
+	{
+		class: classSymbol,
 		order: 38,
 	},
-	'⇔': {
-		class: classScience,
+	// "Ο"
+	{
+		class: classSymbol,
 		order: 39,
 	},
-	'∀': {
-		class: classScience,
+	// "Π"
+	{
+		class: classSymbol,
 		order: 40,
 	},
-	'∃': {
-		class: classScience,
+	// "Ρ"
+	{
+		class: classSymbol,
 		order: 41,
 	},
-	'∴': {
-		class: classScience,
+	// "Σ"
+	{
+		class: classSymbol,
 		order: 42,
 	},
-	'∵': {
-		class: classScience,
+	// "Τ"
+	{
+		class: classSymbol,
 		order: 43,
 	},
-	'♂': {
-		class: classScience,
+	// "Υ"
+	{
+		class: classSymbol,
 		order: 44,
 	},
-	'♀': {
-		class: classScience,
+	// "Φ"
+	{
+		class: classSymbol,
 		order: 45,
 	},
-	'＃': {
-		class: classGeneral,
-		order: 1,
+	// "Χ"
+	{
+		class: classSymbol,
+		order: 46,
 	},
-	'#': {
-		class: classGeneral,
-		order: 1,
+	// "Ψ"
+	{
+		class: classSymbol,
+		order: 47,
 	},
-	'＆': {
-		class: classGeneral,
-		order: 2,
+	// "Ω"
+	{
+		class: classSymbol,
+		order: 48,
 	},
-	'&': {
-		class: classGeneral,
-		order: 2,
+	// "α"
+	{
+		class: classSymbol,
+		order: 1,
 	},
-	'＠': {
-		class: classGeneral,
-		order: 3,
+	// "β"
+	{
+		class: classSymbol,
+		order: 2,
 	},
-	'@': {
-		class: classGeneral,
+	// "γ"
+	{
+		class: classSymbol,
 		order: 3,
 	},
-	'＊': {
-		class: classGeneral,
-		order: 4,
-	},
-	'*': {
-		class: classGeneral,
+	// "δ"
+	{
+		class: classSymbol,
 		order: 4,
 	},
-	'§': {
-		class: classGeneral,
+	// "ε"
+	{
+		class: classSymbol,
 		order: 5,
 	},
-	'¶': {
-		class: classGeneral,
+	// "ζ"
+	{
+		class: classSymbol,
 		order: 6,
 	},
-	'※': {
-		class: classGeneral,
+	// "η"
+	{
+		class: classSymbol,
 		order: 7,
 	},
-	'†': {
-		class: classGeneral,
+	// "θ"
+	{
+		class: classSymbol,
 		order: 8,
 	},
-	'‡': {
-		class: classGeneral,
+	// "ι"
+	{
+		class: classSymbol,
 		order: 9,
 	},
-	'☆': {
-		class: classGeneral,
+	// "κ"
+	{
+		class: classSymbol,
 		order: 10,
 	},
-	'★': {
-		class: classGeneral,
+	// "λ"
+	{
+		class: classSymbol,
 		order: 11,
 	},
-	'○': {
-		class: classGeneral,
+	// "μ"
+	{
+		class: classSymbol,
 		order: 12,
 	},
-	'●': {
-		class: classGeneral,
+	// "ν"
+	{
+		class: classSymbol,
 		order: 13,
 	},
-	'◎': {
-		class: classGeneral,
+	// "ξ"
+	{
+		class: classSymbol,
 		order: 14,
 	},
-	'◇': {
-		class: classGeneral,
+	// "ο"
+	{
+		class: classSymbol,
 		order: 15,
 	},
-	'◆': {
-		class: classGeneral,
+	// "π"
+	{
+		class: classSymbol,
 		order: 16,
 	},
-	'□': {
-		class: classGeneral,
+	// "ρ"
+	{
+		class: classSymbol,
 		order: 17,
 	},
-	'■': {
-		class: classGeneral,
+	// "σ"
+	{
+		class: classSymbol,
 		order: 18,
 	},
-	'△': {
-		class: classGeneral,
+	// "τ"
+	{
+		class: classSymbol,
 		order: 19,
 	},
-	'▲': {
-		class: classGeneral,
+	// "υ"
+	{
+		class: classSymbol,
 		order: 20,
 	},
-	'▽': {
-		class: classGeneral,
+	// "φ"
+	{
+		class: classSymbol,
 		order: 21,
 	},
-	'▼': {
-		class: classGeneral,
+	// "χ"
+	{
+		class: classSymbol,
 		order: 22,
 	},
-	'〒': {
-		class: classGeneral,
+	// "ψ"
+	{
+		class: classSymbol,
 		order: 23,
 	},
-	'→': {
-		class: classGeneral,
+	// "ω"
+	{
+		class: classSymbol,
 		order: 24,
 	},
-	'←': {
-		class: classGeneral,
-		order: 25,
+	// "Ё"
+	{
+		class: classSymbol,
+		order: 88,
 	},
-	'↑': {
-		class: classGeneral,
-		order: 26,
+	// "А"
+	{
+		class: classSymbol,
+		order: 82,
 	},
-	'↓': {
-		class: classGeneral,
-		order: 27,
+	// "Б"
+	{
+		class: classSymbol,
+		order: 83,
 	},
-	'♯': {
-		class: classGeneral,
-		order: 28,
+	// "В"
+	{
+		class: classSymbol,
+		order: 84,
 	},
-	'♭': {
-		class: classGeneral,
-		order: 29,
+	// "Г"
+	{
+		class: classSymbol,
+		order: 85,
 	},
-	'♪': {
-		class: classGeneral,
-		order: 30,
+	// "Д"
+	{
+		class: classSymbol,
+		order: 86,
 	},
-	'°': {
-		class: classUnit,
-		order: 1,
+	// "Е"
+	{
+		class: classSymbol,
+		order: 87,
 	},
-	'′': {
-		class: classUnit,
-		order: 2,
+	// "Ж"
+	{
+		class: classSymbol,
+		order: 89,
 	},
-	'″': {
-		class: classUnit,
-		order: 3,
+	// "З"
+	{
+		class: classSymbol,
+		order: 90,
 	},
-	'℃': {
-		class: classUnit,
-		order: 4,
+	// "И"
+	{
+		class: classSymbol,
+		order: 91,
 	},
-	'￥': {
-		class: classUnit,
-		order: 5,
+	// "Й"
+	{
+		class: classSymbol,
+		order: 92,
 	},
-	'¥': {
-		class: classUnit,
-		order: 5,
+	// "К"
+	{
+		class: classSymbol,
+		order: 93,
 	},
-	'＄': {
-		class: classUnit,
-		order: 6,
+	// "Л"
+	{
+		class: classSymbol,
+		order: 94,
 	},
-	'$': {
-		class: classUnit,
-		order: 6,
+	// "М"
+	{
+		class: classSymbol,
+		order: 95,
 	},
-	'￠': {
-		class: classUnit,
-		order: 7,
+	// "Н"
+	{
+		class: classSymbol,
+		order: 96,
 	},
-	'￡': {
-		class: classUnit,
-		order: 8,
-	},
-	'％': {
-		class: classUnit,
-		order: 9,
-	},
-	'%': {
-		class: classUnit,
-		order: 9,
-	},
-	'‰': {
-		class: classUnit,
-		order: 10,
-	},
-	'Å': {
-		class: classUnit,
-		order: 11,
-	},
-	'0': {
-		class: classNumber,
-		order: 1,
-	},
-	'０': {
-		class: classNumber,
-		order: 1,
-	},
-	'1': {
-		class: classNumber,
-		order: 2,
-	},
-	'１': {
-		class: classNumber,
-		order: 2,
-	},
-	'2': {
-		class: classNumber,
-		order: 3,
-	},
-	'２': {
-		class: classNumber,
-		order: 3,
-	},
-	'3': {
-		class: classNumber,
-		order: 4,
-	},
-	'３': {
-		class: classNumber,
-		order: 4,
-	},
-	'4': {
-		class: classNumber,
-		order: 5,
-	},
-	'４': {
-		class: classNumber,
-		order: 5,
-	},
-	'5': {
-		class: classNumber,
-		order: 6,
-	},
-	'５': {
-		class: classNumber,
-		order: 6,
-	},
-	'6': {
-		class: classNumber,
-		order: 7,
-	},
-	'６': {
-		class: classNumber,
-		order: 7,
-	},
-	'7': {
-		class: classNumber,
-		order: 8,
-	},
-	'７': {
-		class: classNumber,
-		order: 8,
-	},
-	'8': {
-		class: classNumber,
-		order: 9,
-	},
-	'８': {
-		class: classNumber,
-		order: 9,
-	},
-	'9': {
-		class: classNumber,
-		order: 10,
-	},
-	'９': {
-		class: classNumber,
-		order: 10,
-	},
-	'α': {
-		class: classSymbol,
-		order: 1,
-	},
-	'β': {
-		class: classSymbol,
-		order: 2,
-	},
-	'γ': {
-		class: classSymbol,
-		order: 3,
-	},
-	'δ': {
-		class: classSymbol,
-		order: 4,
-	},
-	'ε': {
-		class: classSymbol,
-		order: 5,
-	},
-	'ζ': {
-		class: classSymbol,
-		order: 6,
-	},
-	'η': {
-		class: classSymbol,
-		order: 7,
-	},
-	'θ': {
-		class: classSymbol,
-		order: 8,
-	},
-	'ι': {
-		class: classSymbol,
-		order: 9,
-	},
-	'κ': {
-		class: classSymbol,
-		order: 10,
-	},
-	'λ': {
-		class: classSymbol,
-		order: 11,
-	},
-	'μ': {
-		class: classSymbol,
-		order: 12,
-	},
-	'ν': {
-		class: classSymbol,
-		order: 13,
-	},
-	'ξ': {
-		class: classSymbol,
-		order: 14,
-	},
-	'ο': {
-		class: classSymbol,
-		order: 15,
-	},
-	'π': {
-		class: classSymbol,
-		order: 16,
-	},
-	'ρ': {
-		class: classSymbol,
-		order: 17,
-	},
-	'σ': {
-		class: classSymbol,
-		order: 18,
-	},
-	'τ': {
+	// "О"
+	{
 		class: classSymbol,
-		order: 19,
-	},
-	'υ': {
-		class: classSymbol,
-		order: 20,
-	},
-	'φ': {
-		class: classSymbol,
-		order: 21,
-	},
-	'χ': {
-		class: classSymbol,
-		order: 22,
-	},
-	'ψ': {
-		class: classSymbol,
-		order: 23,
-	},
-	'ω': {
-		class: classSymbol,
-		order: 24,
-	},
-	'Α': {
-		class: classSymbol,
-		order: 25,
-	},
-	'Β': {
-		class: classSymbol,
-		order: 26,
-	},
-	'Γ': {
-		class: classSymbol,
-		order: 27,
-	},
-	'Δ': {
-		class: classSymbol,
-		order: 28,
-	},
-	'Ε': {
-		class: classSymbol,
-		order: 29,
-	},
-	'Ζ': {
-		class: classSymbol,
-		order: 30,
-	},
-	'Η': {
-		class: classSymbol,
-		order: 31,
+		order: 97,
 	},
-	'Θ': {
+	// "П"
+	{
 		class: classSymbol,
-		order: 32,
+		order: 98,
 	},
-	'Ι': {
+	// "Р"
+	{
 		class: classSymbol,
-		order: 33,
+		order: 99,
 	},
-	'Κ': {
+	// "С"
+	{
 		class: classSymbol,
-		order: 34,
+		order: 100,
 	},
-	'Λ': {
+	// "Т"
+	{
 		class: classSymbol,
-		order: 35,
+		order: 101,
 	},
-	'Μ': {
+	// "У"
+	{
 		class: classSymbol,
-		order: 36,
+		order: 102,
 	},
-	'Ν': {
+	// "Ф"
+	{
 		class: classSymbol,
-		order: 37,
+		order: 103,
 	},
-	'Ξ': {
+	// "Х"
+	{
 		class: classSymbol,
-		order: 38,
+		order: 104,
 	},
-	'Ο': {
+	// "Ц"
+	{
 		class: classSymbol,
-		order: 39,
+		order: 105,
 	},
-	'Π': {
+	// "Ч"
+	{
 		class: classSymbol,
-		order: 40,
+		order: 106,
 	},
-	'Ρ': {
+	// "Ш"
+	{
 		class: classSymbol,
-		order: 41,
+		order: 107,
 	},
-	'Σ': {
+	// "Щ"
+	{
 		class: classSymbol,
-		order: 42,
+		order: 108,
 	},
-	'Τ': {
+	// "Ъ"
+	{
 		class: classSymbol,
-		order: 43,
+		order: 109,
 	},
-	'Υ': {
+	// "Ы"
+	{
 		class: classSymbol,
-		order: 44,
+		order: 110,
 	},
-	'Φ': {
+	// "Ь"
+	{
 		class: classSymbol,
-		order: 45,
+		order: 111,
 	},
-	'Χ': {
+	// "Э"
+	{
 		class: classSymbol,
-		order: 46,
+		order: 112,
 	},
-	'Ψ': {
+	// "Ю"
+	{
 		class: classSymbol,
-		order: 47,
+		order: 113,
 	},
-	'Ω': {
+	// "Я"
+	{
 		class: classSymbol,
-		order: 48,
+		order: 114,
 	},
-	'а': {
+	// "а"
+	{
 		class: classSymbol,
 		order: 49,
 	},
-	'б': {
+	// "б"
+	{
 		class: classSymbol,
 		order: 50,
 	},
-	'в': {
+	// "в"
+	{
 		class: classSymbol,
 		order: 51,
 	},
-	'г': {
+	// "г"
+	{
 		class: classSymbol,
 		order: 52,
 	},
-	'д': {
+	// "д"
+	{
 		class: classSymbol,
 		order: 53,
 	},
-	'е': {
+	// "е"
+	{
 		class: classSymbol,
 		order: 54,
 	},
-	'ё': {
-		class: classSymbol,
-		order: 55,
-	},
-	'ж': {
+	// "ж"
+	{
 		class: classSymbol,
 		order: 56,
 	},
-	'з': {
+	// "з"
+	{
 		class: classSymbol,
 		order: 57,
 	},
-	'и': {
+	// "и"
+	{
 		class: classSymbol,
 		order: 58,
 	},
-	'й': {
+	// "й"
+	{
 		class: classSymbol,
 		order: 59,
 	},
-	'к': {
+	// "к"
+	{
 		class: classSymbol,
 		order: 60,
 	},
-	'л': {
+	// "л"
+	{
 		class: classSymbol,
 		order: 61,
 	},
-	'м': {
+	// "м"
+	{
 		class: classSymbol,
 		order: 62,
 	},
-	'н': {
+	// "н"
+	{
 		class: classSymbol,
 		order: 63,
 	},
-	'о': {
+	// "о"
+	{
 		class: classSymbol,
 		order: 64,
 	},
-	'п': {
+	// "п"
+	{
 		class: classSymbol,
 		order: 65,
 	},
-	'р': {
+	// "р"
+	{
 		class: classSymbol,
 		order: 66,
 	},
-	'с': {
+	// "с"
+	{
 		class: classSymbol,
 		order: 67,
 	},
-	'т': {
+	// "т"
+	{
 		class: classSymbol,
 		order: 68,
 	},
-	'у': {
+	// "у"
+	{
 		class: classSymbol,
 		order: 69,
 	},
-	'ф': {
+	// "ф"
+	{
 		class: classSymbol,
 		order: 70,
 	},
-	'х': {
+	// "х"
+	{
 		class: classSymbol,
 		order: 71,
 	},
-	'ц': {
+	// "ц"
+	{
 		class: classSymbol,
 		order: 72,
 	},
-	'ч': {
+	// "ч"
+	{
 		class: classSymbol,
 		order: 73,
 	},
-	'ш': {
+	// "ш"
+	{
 		class: classSymbol,
 		order: 74,
 	},
-	'щ': {
+	// "щ"
+	{
 		class: classSymbol,
 		order: 75,
 	},
-	'ъ': {
+	// "ъ"
+	{
 		class: classSymbol,
 		order: 76,
 	},
-	'ы': {
+	// "ы"
+	{
 		class: classSymbol,
 		order: 77,
 	},
-	'ь': {
+	// "ь"
+	{
 		class: classSymbol,
 		order: 78,
 	},
-	'э': {
+	// "э"
+	{
 		class: classSymbol,
 		order: 79,
 	},
-	'ю': {
+	// "ю"
+	{
 		class: classSymbol,
 		order: 80,
 	},
-	'я': {
+	// "я"
+	{
 		class: classSymbol,
 		order: 81,
 	},
-	'А': {
-		class: classSymbol,
-		order: 82,
-	},
-	'Б': {
+	// "ё"
+	{
 		class: classSymbol,
-		order: 83,
+		order: 55,
 	},
-	'В': {
-		class: classSymbol,
-		order: 84,
+	// "‐"
+	{
+		class: classDescriptor,
+		order: 13,
 	},
-	'Г': {
-		class: classSymbol,
-		order: 85,
+	// "―"
+	{
+		class: classDescriptor,
+		order: 12,
 	},
-	'Д': {
-		class: classSymbol,
-		order: 86,
+	// "‘"
+	{
+		class: classBracket,
+		order: 1,
 	},
-	'Е': {
-		class: classSymbol,
-		order: 87,
+	// "’"
+	{
+		class: classBracket,
+		order: 2,
 	},
-	'Ё': {
-		class: classSymbol,
-		order: 88,
+	// "“"
+	{
+		class: classBracket,
+		order: 3,
 	},
-	'Ж': {
-		class: classSymbol,
-		order: 89,
+	// "”"
+	{
+		class: classBracket,
+		order: 4,
 	},
-	'З': {
-		class: classSymbol,
-		order: 90,
+	// "†"
+	{
+		class: classGeneral,
+		order: 8,
 	},
-	'И': {
-		class: classSymbol,
-		order: 91,
+	// "‡"
+	{
+		class: classGeneral,
+		order: 9,
 	},
-	'Й': {
-		class: classSymbol,
-		order: 92,
+	// "‥"
+	{
+		class: classDescriptor,
+		order: 20,
 	},
-	'К': {
-		class: classSymbol,
-		order: 93,
+	// "…"
+	{
+		class: classDescriptor,
+		order: 19,
 	},
-	'Л': {
-		class: classSymbol,
-		order: 94,
+	// "‰"
+	{
+		class: classUnit,
+		order: 10,
 	},
-	'М': {
-		class: classSymbol,
-		order: 95,
+	// "′"
+	{
+		class: classUnit,
+		order: 2,
 	},
-	'Н': {
-		class: classSymbol,
-		order: 96,
+	// "″"
+	{
+		class: classUnit,
+		order: 3,
 	},
-	'О': {
-		class: classSymbol,
-		order: 97,
+	// "※"
+	{
+		class: classGeneral,
+		order: 7,
 	},
-	'П': {
-		class: classSymbol,
-		order: 98,
+	// "℃"
+	{
+		class: classUnit,
+		order: 4,
 	},
-	'Р': {
-		class: classSymbol,
-		order: 99,
+	// "←"
+	{
+		class: classGeneral,
+		order: 25,
 	},
-	'С': {
-		class: classSymbol,
-		order: 100,
+	// "↑"
+	{
+		class: classGeneral,
+		order: 26,
 	},
-	'Т': {
-		class: classSymbol,
-		order: 101,
+	// "→"
+	{
+		class: classGeneral,
+		order: 24,
 	},
-	'У': {
-		class: classSymbol,
-		order: 102,
+	// "↓"
+	{
+		class: classGeneral,
+		order: 27,
 	},
-	'Ф': {
-		class: classSymbol,
-		order: 103,
+	// "⇒"
+	{
+		class: classScience,
+		order: 38,
 	},
-	'Х': {
-		class: classSymbol,
-		order: 104,
+	// "⇔"
+	{
+		class: classScience,
+		order: 39,
 	},
-	'Ц': {
-		class: classSymbol,
-		order: 105,
+	// "∀"
+	{
+		class: classScience,
+		order: 40,
 	},
-	'Ч': {
-		class: classSymbol,
-		order: 106,
+	// "∂"
+	{
+		class: classScience,
+		order: 17,
 	},
-	'Ш': {
-		class: classSymbol,
-		order: 107,
+	// "∃"
+	{
+		class: classScience,
+		order: 41,
 	},
-	'Щ': {
-		class: classSymbol,
-		order: 108,
+	// "∇"
+	{
+		class: classScience,
+		order: 18,
 	},
-	'Ъ': {
-		class: classSymbol,
-		order: 109,
+	// "∈"
+	{
+		class: classScience,
+		order: 27,
 	},
-	'Ы': {
-		class: classSymbol,
-		order: 110,
+	// "∋"
+	{
+		class: classScience,
+		order: 28,
 	},
-	'Ь': {
-		class: classSymbol,
-		order: 111,
+	// "√"
+	{
+		class: classScience,
+		order: 19,
 	},
-	'Э': {
-		class: classSymbol,
-		order: 112,
+	// "∝"
+	{
+		class: classScience,
+		order: 15,
 	},
-	'Ю': {
-		class: classSymbol,
-		order: 113,
+	// "∞"
+	{
+		class: classScience,
+		order: 16,
 	},
-	'Я': {
-		class: classSymbol,
-		order: 114,
+	// "∠"
+	{
+		class: classScience,
+		order: 22,
 	},
-	'a': {
-		class:           classAlphabet,
-		order:           1,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "∥"
+	{
+		class: classDescriptor,
+		order: 17,
 	},
-	'ａ': {
-		class:           classAlphabet,
-		order:           1,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "∧"
+	{
+		class: classScience,
+		order: 35,
 	},
-	'A': {
-		class:           classAlphabet,
-		order:           1,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "∨"
+	{
+		class: classScience,
+		order: 36,
 	},
-	'Ａ': {
-		class:           classAlphabet,
-		order:           1,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "∩"
+	{
+		class: classScience,
+		order: 34,
 	},
-	'ā': {
-		class:           classAlphabet,
-		order:           1,
-		diacriticalMark: diacriticalMarkMacron,
-		letterCase:      letterCaseLower,
+	// "∪"
+	{
+		class: classScience,
+		order: 33,
 	},
-	'Ā': {
-		class:           classAlphabet,
-		order:           1,
-		diacriticalMark: diacriticalMarkMacron,
-		letterCase:      letterCaseUpper,
+	// "∫"
+	{
+		class: classScience,
+		order: 20,
 	},
-	'â': {
-		class:           classAlphabet,
-		order:           1,
-		diacriticalMark: diacriticalMarkCircumflexAccent,
-		letterCase:      letterCaseLower,
+	// "∬"
+	{
+		class: classScience,
+		order: 21,
 	},
-	'Â': {
-		class:           classAlphabet,
-		order:           1,
-		diacriticalMark: diacriticalMarkCircumflexAccent,
-		letterCase:      letterCaseUpper,
+	// "∴"
+	{
+		class: classScience,
+		order: 42,
 	},
-	'b': {
-		class:           classAlphabet,
-		order:           2,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "∵"
+	{
+		class: classScience,
+		order: 43,
 	},
-	'ｂ': {
-		class:           classAlphabet,
-		order:           2,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "∽"
+	{
+		class: classScience,
+		order: 26,
 	},
-	'B': {
-		class:           classAlphabet,
-		order:           2,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "≒"
+	{
+		class: classScience,
+		order: 12,
 	},
-	'Ｂ': {
-		class:           classAlphabet,
-		order:           2,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "≠"
+	{
+		class: classScience,
+		order: 7,
 	},
-	'c': {
-		class:           classAlphabet,
-		order:           3,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "≡"
+	{
+		class: classScience,
+		order: 25,
 	},
-	'ｃ': {
-		class:           classAlphabet,
-		order:           3,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "≦"
+	{
+		class: classScience,
+		order: 10,
 	},
-	'C': {
-		class:           classAlphabet,
-		order:           3,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "≧"
+	{
+		class: classScience,
+		order: 11,
 	},
-	'Ｃ': {
-		class:           classAlphabet,
-		order:           3,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "≪"
+	{
+		class: classScience,
+		order: 13,
 	},
-	'd': {
-		class:           classAlphabet,
-		order:           4,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "≫"
+	{
+		class: classScience,
+		order: 14,
 	},
-	'ｄ': {
-		class:           classAlphabet,
-		order:           4,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "⊂"
+	{
+		class: classScience,
+		order: 31,
 	},
-	'D': {
-		class:           classAlphabet,
-		order:           4,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "⊃"
+	{
+		class: classScience,
+		order: 32,
 	},
-	'Ｄ': {
-		class:           classAlphabet,
-		order:           4,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "⊆"
+	{
+		class: classScience,
+		order: 29,
 	},
-	'e': {
-		class:           classAlphabet,
-		order:           5,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "⊇"
+	{
+		class: classScience,
+		order: 30,
 	},
-	'ｅ': {
-		class:           classAlphabet,
-		order:           5,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "⊥"
+	{
+		class: classScience,
+		order: 23,
 	},
-	'E': {
-		class:           classAlphabet,
-		order:           5,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "⌒"
+	{
+		class: classScience,
+		order: 24,
 	},
-	'Ｅ': {
-		class:           classAlphabet,
-		order:           5,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "■"
+	{
+		class: classGeneral,
+		order: 18,
 	},
-	'ē': {
-		class:           classAlphabet,
-		order:           5,
-		diacriticalMark: diacriticalMarkMacron,
-		letterCase:      letterCaseLower,
+	// "□"
+	{
+		class: classGeneral,
+		order: 17,
 	},
-	'Ē': {
-		class:           classAlphabet,
-		order:           5,
-		diacriticalMark: diacriticalMarkMacron,
-		letterCase:      letterCaseUpper,
+	// "▲"
+	{
+		class: classGeneral,
+		order: 20,
 	},
-	'ê': {
-		class:           classAlphabet,
-		order:           5,
-		diacriticalMark: diacriticalMarkCircumflexAccent,
-		letterCase:      letterCaseLower,
+	// "△"
+	{
+		class: classGeneral,
+		order: 19,
 	},
-	'Ê': {
-		class:           classAlphabet,
-		order:           5,
-		diacriticalMark: diacriticalMarkCircumflexAccent,
-		letterCase:      letterCaseUpper,
+	// "▼"
+	{
+		class: classGeneral,
+		order: 22,
 	},
-	'f': {
-		class:           classAlphabet,
-		order:           6,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "▽"
+	{
+		class: classGeneral,
+		order: 21,
 	},
-	'ｆ': {
-		class:           classAlphabet,
-		order:           6,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "◆"
+	{
+		class: classGeneral,
+		order: 16,
 	},
-	'F': {
-		class:           classAlphabet,
-		order:           6,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "◇"
+	{
+		class: classGeneral,
+		order: 15,
 	},
-	'Ｆ': {
-		class:           classAlphabet,
-		order:           6,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "○"
+	{
+		class: classGeneral,
+		order: 12,
 	},
-	'g': {
-		class:           classAlphabet,
-		order:           7,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "◎"
+	{
+		class: classGeneral,
+		order: 14,
 	},
-	'ｇ': {
-		class:           classAlphabet,
-		order:           7,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "●"
+	{
+		class: classGeneral,
+		order: 13,
 	},
-	'G': {
-		class:           classAlphabet,
-		order:           7,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "★"
+	{
+		class: classGeneral,
+		order: 11,
 	},
-	'Ｇ': {
-		class:           classAlphabet,
-		order:           7,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "☆"
+	{
+		class: classGeneral,
+		order: 10,
 	},
-	'h': {
-		class:           classAlphabet,
-		order:           8,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "♀"
+	{
+		class: classScience,
+		order: 45,
 	},
-	'ｈ': {
-		class:           classAlphabet,
-		order:           8,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "♂"
+	{
+		class: classScience,
+		order: 44,
 	},
-	'H': {
-		class:           classAlphabet,
-		order:           8,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "♪"
+	{
+		class: classGeneral,
+		order: 30,
 	},
-	'Ｈ': {
-		class:           classAlphabet,
-		order:           8,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'i': {
-		class:           classAlphabet,
-		order:           9,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'ｉ': {
-		class:           classAlphabet,
-		order:           9,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'I': {
-		class:           classAlphabet,
-		order:           9,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'Ｉ': {
-		class:           classAlphabet,
-		order:           9,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'ī': {
-		class:           classAlphabet,
-		order:           9,
-		diacriticalMark: diacriticalMarkMacron,
-		letterCase:      letterCaseLower,
-	},
-	'Ī': {
-		class:           classAlphabet,
-		order:           9,
-		diacriticalMark: diacriticalMarkMacron,
-		letterCase:      letterCaseUpper,
-	},
-	'î': {
-		class:           classAlphabet,
-		order:           9,
-		diacriticalMark: diacriticalMarkCircumflexAccent,
-		letterCase:      letterCaseLower,
-	},
-	'Î': {
-		class:           classAlphabet,
-		order:           9,
-		diacriticalMark: diacriticalMarkCircumflexAccent,
-		letterCase:      letterCaseUpper,
-	},
-	'j': {
-		class:           classAlphabet,
-		order:           10,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'ｊ': {
-		class:           classAlphabet,
-		order:           10,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'J': {
-		class:           classAlphabet,
-		order:           10,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'Ｊ': {
-		class:           classAlphabet,
-		order:           10,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'k': {
-		class:           classAlphabet,
-		order:           11,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'ｋ': {
-		class:           classAlphabet,
-		order:           11,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'K': {
-		class:           classAlphabet,
-		order:           11,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'Ｋ': {
-		class:           classAlphabet,
-		order:           11,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'l': {
-		class:           classAlphabet,
-		order:           12,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'ｌ': {
-		class:           classAlphabet,
-		order:           12,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'L': {
-		class:           classAlphabet,
-		order:           12,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'Ｌ': {
-		class:           classAlphabet,
-		order:           12,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'm': {
-		class:           classAlphabet,
-		order:           13,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'ｍ': {
-		class:           classAlphabet,
-		order:           13,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'M': {
-		class:           classAlphabet,
-		order:           13,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'Ｍ': {
-		class:           classAlphabet,
-		order:           13,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'n': {
-		class:           classAlphabet,
-		order:           14,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'ｎ': {
-		class:           classAlphabet,
-		order:           14,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'N': {
-		class:           classAlphabet,
-		order:           14,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'Ｎ': {
-		class:           classAlphabet,
-		order:           14,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'o': {
-		class:           classAlphabet,
-		order:           15,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'ｏ': {
-		class:           classAlphabet,
-		order:           15,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'O': {
-		class:           classAlphabet,
-		order:           15,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'Ｏ': {
-		class:           classAlphabet,
-		order:           15,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'ō': {
-		class:           classAlphabet,
-		order:           15,
-		diacriticalMark: diacriticalMarkMacron,
-		letterCase:      letterCaseLower,
-	},
-	'Ō': {
-		class:           classAlphabet,
-		order:           15,
-		diacriticalMark: diacriticalMarkMacron,
-		letterCase:      letterCaseUpper,
-	},
-	'ô': {
-		class:           classAlphabet,
-		order:           15,
-		diacriticalMark: diacriticalMarkCircumflexAccent,
-		letterCase:      letterCaseLower,
-	},
-	'Ô': {
-		class:           classAlphabet,
-		order:           15,
-		diacriticalMark: diacriticalMarkCircumflexAccent,
-		letterCase:      letterCaseUpper,
-	},
-	'p': {
-		class:           classAlphabet,
-		order:           16,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'ｐ': {
-		class:           classAlphabet,
-		order:           16,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'P': {
-		class:           classAlphabet,
-		order:           16,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'Ｐ': {
-		class:           classAlphabet,
-		order:           16,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
-	},
-	'q': {
-		class:           classAlphabet,
-		order:           17,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'ｑ': {
-		class:           classAlphabet,
-		order:           17,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
-	},
-	'Q': {
-		class:           classAlphabet,
-		order:           17,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "♭"
+	{
+		class: classGeneral,
+		order: 29,
 	},
-	'Ｑ': {
-		class:           classAlphabet,
-		order:           17,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "♯"
+	{
+		class: classGeneral,
+		order: 28,
 	},
-	'r': {
-		class:           classAlphabet,
-		order:           18,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "、"
+	{
+		class: classDescriptor,
+		order: 1,
 	},
-	'ｒ': {
-		class:           classAlphabet,
-		order:           18,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "。"
+	{
+		class: classDescriptor,
+		order: 2,
 	},
-	'R': {
-		class:           classAlphabet,
-		order:           18,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "〃"
+	{
+		class: classKanji,
+		order: 1,
 	},
-	'Ｒ': {
-		class:           classAlphabet,
-		order:           18,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "々"
+	{
+		class: classKanji,
+		order: 3,
 	},
-	's': {
-		class:           classAlphabet,
-		order:           19,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "〆"
+	{
+		class: classKanji,
+		order: 4,
 	},
-	'ｓ': {
-		class:           classAlphabet,
-		order:           19,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "〇"
+	{
+		class: classKanji,
+		order: 5,
 	},
-	'S': {
-		class:           classAlphabet,
-		order:           19,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "〈"
+	{
+		class: classBracket,
+		order: 13,
 	},
-	'Ｓ': {
-		class:           classAlphabet,
-		order:           19,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "〉"
+	{
+		class: classBracket,
+		order: 14,
 	},
-	't': {
-		class:           classAlphabet,
-		order:           20,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "《"
+	{
+		class: classBracket,
+		order: 15,
 	},
-	'ｔ': {
-		class:           classAlphabet,
-		order:           20,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "》"
+	{
+		class: classBracket,
+		order: 16,
 	},
-	'T': {
-		class:           classAlphabet,
-		order:           20,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "「"
+	{
+		class: classBracket,
+		order: 17,
 	},
-	'Ｔ': {
-		class:           classAlphabet,
-		order:           20,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "」"
+	{
+		class: classBracket,
+		order: 18,
 	},
-	'u': {
-		class:           classAlphabet,
-		order:           21,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "『"
+	{
+		class: classBracket,
+		order: 19,
 	},
-	'ｕ': {
-		class:           classAlphabet,
-		order:           21,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "』"
+	{
+		class: classBracket,
+		order: 20,
 	},
-	'U': {
-		class:           classAlphabet,
-		order:           21,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "【"
+	{
+		class: classBracket,
+		order: 21,
 	},
-	'Ｕ': {
-		class:           classAlphabet,
-		order:           21,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "】"
+	{
+		class: classBracket,
+		order: 22,
 	},
-	'ū': {
-		class:           classAlphabet,
-		order:           21,
-		diacriticalMark: diacriticalMarkMacron,
-		letterCase:      letterCaseLower,
+	// "〒"
+	{
+		class: classGeneral,
+		order: 23,
 	},
-	'Ū': {
-		class:           classAlphabet,
-		order:           21,
-		diacriticalMark: diacriticalMarkMacron,
-		letterCase:      letterCaseUpper,
+	// "〓"
+	{
+		class: classGeta,
+		order: 1,
 	},
-	'û': {
-		class:           classAlphabet,
-		order:           21,
-		diacriticalMark: diacriticalMarkCircumflexAccent,
-		letterCase:      letterCaseLower,
+	// "〔"
+	{
+		class: classBracket,
+		order: 7,
 	},
-	'Û': {
-		class:           classAlphabet,
-		order:           21,
-		diacriticalMark: diacriticalMarkCircumflexAccent,
-		letterCase:      letterCaseUpper,
+	// "〕"
+	{
+		class: classBracket,
+		order: 8,
 	},
-	'v': {
-		class:           classAlphabet,
-		order:           22,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "ぁ"
+	{
+		class:      classKana,
+		order:      1,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeLower,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ｖ': {
-		class:           classAlphabet,
-		order:           22,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "あ"
+	{
+		class:      classKana,
+		order:      1,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'V': {
-		class:           classAlphabet,
-		order:           22,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "ぃ"
+	{
+		class:      classKana,
+		order:      2,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeLower,
+		kanaType:   kanaTypeHiragana,
 	},
-	'Ｖ': {
-		class:           classAlphabet,
-		order:           22,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "い"
+	{
+		class:      classKana,
+		order:      2,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'w': {
-		class:           classAlphabet,
-		order:           23,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "ぅ"
+	{
+		class:      classKana,
+		order:      3,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeLower,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ｗ': {
-		class:           classAlphabet,
-		order:           23,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "う"
+	{
+		class:      classKana,
+		order:      3,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'W': {
-		class:           classAlphabet,
-		order:           23,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "ぇ"
+	{
+		class:      classKana,
+		order:      4,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeLower,
+		kanaType:   kanaTypeHiragana,
 	},
-	'Ｗ': {
-		class:           classAlphabet,
-		order:           23,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "え"
+	{
+		class:      classKana,
+		order:      4,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'x': {
-		class:           classAlphabet,
-		order:           24,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "ぉ"
+	{
+		class:      classKana,
+		order:      5,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeLower,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ｘ': {
-		class:           classAlphabet,
-		order:           24,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "お"
+	{
+		class:      classKana,
+		order:      5,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'X': {
-		class:           classAlphabet,
-		order:           24,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "か"
+	{
+		class:      classKana,
+		order:      6,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'Ｘ': {
-		class:           classAlphabet,
-		order:           24,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "が"
+	{
+		class:      classKana,
+		order:      6,
+		voiced:     voicedVoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'y': {
-		class:           classAlphabet,
-		order:           25,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "き"
+	{
+		class:      classKana,
+		order:      7,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ｙ': {
-		class:           classAlphabet,
-		order:           25,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "ぎ"
+	{
+		class:      classKana,
+		order:      7,
+		voiced:     voicedVoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'Y': {
-		class:           classAlphabet,
-		order:           25,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "く"
+	{
+		class:      classKana,
+		order:      8,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'Ｙ': {
-		class:           classAlphabet,
-		order:           25,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "ぐ"
+	{
+		class:      classKana,
+		order:      8,
+		voiced:     voicedVoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'z': {
-		class:           classAlphabet,
-		order:           26,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "け"
+	{
+		class:      classKana,
+		order:      9,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ｚ': {
-		class:           classAlphabet,
-		order:           26,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseLower,
+	// "げ"
+	{
+		class:      classKana,
+		order:      9,
+		voiced:     voicedVoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'Z': {
-		class:           classAlphabet,
-		order:           26,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "こ"
+	{
+		class:      classKana,
+		order:      10,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'Ｚ': {
-		class:           classAlphabet,
-		order:           26,
-		diacriticalMark: diacriticalMarkNone,
-		letterCase:      letterCaseUpper,
+	// "ご"
+	{
+		class:      classKana,
+		order:      10,
+		voiced:     voicedVoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ぁ': {
+	// "さ"
+	{
 		class:      classKana,
-		order:      1,
+		order:      11,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
+		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ァ': {
+	// "ざ"
+	{
 		class:      classKana,
-		order:      1,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeKatakana,
+		order:      11,
+		voiced:     voicedVoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'あ': {
+	// "し"
+	{
 		class:      classKana,
-		order:      1,
+		order:      12,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ア': {
+	// "じ"
+	{
+		class:      classKana,
+		order:      12,
+		voiced:     voicedVoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
+	},
+	// "す"
+	{
 		class:      classKana,
-		order:      1,
+		order:      13,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ぃ': {
+	// "ず"
+	{
 		class:      classKana,
-		order:      2,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
+		order:      13,
+		voiced:     voicedVoiced,
+		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ィ': {
+	// "せ"
+	{
 		class:      classKana,
-		order:      2,
+		order:      14,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeKatakana,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'い': {
+	// "ぜ"
+	{
 		class:      classKana,
-		order:      2,
-		voiced:     voicedUnvoiced,
+		order:      14,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'イ': {
+	// "そ"
+	{
 		class:      classKana,
-		order:      2,
+		order:      15,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ぅ': {
+	// "ぞ"
+	{
 		class:      classKana,
-		order:      3,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
+		order:      15,
+		voiced:     voicedVoiced,
+		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ゥ': {
+	// "た"
+	{
 		class:      classKana,
-		order:      3,
+		order:      16,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeKatakana,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'う': {
+	// "だ"
+	{
 		class:      classKana,
-		order:      3,
-		voiced:     voicedUnvoiced,
+		order:      16,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ウ': {
+	// "ち"
+	{
 		class:      classKana,
-		order:      3,
+		order:      17,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ヴ': {
+	// "ぢ"
+	{
 		class:      classKana,
-		order:      3,
+		order:      17,
 		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
-	},
-	'ぇ': {
-		class:      classKana,
-		order:      4,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ェ': {
+	// "っ"
+	{
 		class:      classKana,
-		order:      4,
+		order:      18,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'え': {
+	// "つ"
+	{
 		class:      classKana,
-		order:      4,
+		order:      18,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'エ': {
+	// "づ"
+	{
 		class:      classKana,
-		order:      4,
-		voiced:     voicedUnvoiced,
+		order:      18,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ぉ': {
+	// "て"
+	{
 		class:      classKana,
-		order:      5,
+		order:      19,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
+		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ォ': {
+	// "で"
+	{
 		class:      classKana,
-		order:      5,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeKatakana,
+		order:      19,
+		voiced:     voicedVoiced,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeHiragana,
 	},
-	'お': {
+	// "と"
+	{
 		class:      classKana,
-		order:      5,
+		order:      20,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'オ': {
+	// "ど"
+	{
 		class:      classKana,
-		order:      5,
-		voiced:     voicedUnvoiced,
+		order:      20,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'か': {
+	// "な"
+	{
 		class:      classKana,
-		order:      6,
+		order:      21,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'カ': {
+	// "に"
+	{
 		class:      classKana,
-		order:      6,
+		order:      22,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'が': {
+	// "ぬ"
+	{
 		class:      classKana,
-		order:      6,
-		voiced:     voicedVoiced,
+		order:      23,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ガ': {
+	// "ね"
+	{
 		class:      classKana,
-		order:      6,
-		voiced:     voicedVoiced,
+		order:      24,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'き': {
+	// "の"
+	{
 		class:      classKana,
-		order:      7,
+		order:      25,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'キ': {
+	// "は"
+	{
 		class:      classKana,
-		order:      7,
+		order:      26,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ぎ': {
+	// "ば"
+	{
 		class:      classKana,
-		order:      7,
+		order:      26,
 		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ギ': {
+	// "ぱ"
+	{
 		class:      classKana,
-		order:      7,
-		voiced:     voicedVoiced,
+		order:      26,
+		voiced:     voicedSemivoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'く': {
+	// "ひ"
+	{
 		class:      classKana,
-		order:      8,
+		order:      27,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ク': {
+	// "び"
+	{
 		class:      classKana,
-		order:      8,
-		voiced:     voicedUnvoiced,
+		order:      27,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ぐ': {
+	// "ぴ"
+	{
 		class:      classKana,
-		order:      8,
-		voiced:     voicedVoiced,
+		order:      27,
+		voiced:     voicedSemivoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'グ': {
+	// "ふ"
+	{
 		class:      classKana,
-		order:      8,
-		voiced:     voicedVoiced,
+		order:      28,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'け': {
+	// "ぶ"
+	{
 		class:      classKana,
-		order:      9,
-		voiced:     voicedUnvoiced,
+		order:      28,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ケ': {
+	// "ぷ"
+	{
 		class:      classKana,
-		order:      9,
-		voiced:     voicedUnvoiced,
+		order:      28,
+		voiced:     voicedSemivoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'げ': {
+	// "へ"
+	{
 		class:      classKana,
-		order:      9,
-		voiced:     voicedVoiced,
+		order:      29,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ゲ': {
+	// "べ"
+	{
 		class:      classKana,
-		order:      9,
+		order:      29,
 		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'こ': {
+	// "ぺ"
+	{
 		class:      classKana,
-		order:      10,
-		voiced:     voicedUnvoiced,
+		order:      29,
+		voiced:     voicedSemivoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'コ': {
+	// "ほ"
+	{
 		class:      classKana,
-		order:      10,
+		order:      30,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ご': {
+	// "ぼ"
+	{
 		class:      classKana,
-		order:      10,
+		order:      30,
 		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ゴ': {
+	// "ぽ"
+	{
 		class:      classKana,
-		order:      10,
-		voiced:     voicedVoiced,
+		order:      30,
+		voiced:     voicedSemivoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'さ': {
+	// "ま"
+	{
 		class:      classKana,
-		order:      11,
+		order:      31,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'サ': {
+	// "み"
+	{
 		class:      classKana,
-		order:      11,
+		order:      32,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ざ': {
+	// "む"
+	{
 		class:      classKana,
-		order:      11,
-		voiced:     voicedVoiced,
+		order:      33,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ザ': {
+	// "め"
+	{
 		class:      classKana,
-		order:      11,
-		voiced:     voicedVoiced,
+		order:      34,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'し': {
+	// "も"
+	{
 		class:      classKana,
-		order:      12,
+		order:      35,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'シ': {
+	// "ゃ"
+	{
 		class:      classKana,
-		order:      12,
+		order:      36,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		symbolType: symbolTypeLower,
+		kanaType:   kanaTypeHiragana,
 	},
-	'じ': {
+	// "や"
+	{
 		class:      classKana,
-		order:      12,
-		voiced:     voicedVoiced,
+		order:      36,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ジ': {
+	// "ゅ"
+	{
 		class:      classKana,
-		order:      12,
-		voiced:     voicedVoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		order:      37,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeLower,
+		kanaType:   kanaTypeHiragana,
 	},
-	'す': {
+	// "ゆ"
+	{
 		class:      classKana,
-		order:      13,
+		order:      37,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ス': {
+	// "ょ"
+	{
 		class:      classKana,
-		order:      13,
+		order:      38,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		symbolType: symbolTypeLower,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ず': {
+	// "よ"
+	{
 		class:      classKana,
-		order:      13,
-		voiced:     voicedVoiced,
+		order:      38,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ズ': {
+	// "ら"
+	{
 		class:      classKana,
-		order:      13,
-		voiced:     voicedVoiced,
+		order:      39,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'せ': {
+	// "り"
+	{
 		class:      classKana,
-		order:      14,
+		order:      40,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'セ': {
+	// "る"
+	{
 		class:      classKana,
-		order:      14,
+		order:      41,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ぜ': {
+	// "れ"
+	{
 		class:      classKana,
-		order:      14,
-		voiced:     voicedVoiced,
+		order:      42,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ゼ': {
+	// "ろ"
+	{
 		class:      classKana,
-		order:      14,
-		voiced:     voicedVoiced,
+		order:      43,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'そ': {
+	// "ゎ"
+	{
 		class:      classKana,
-		order:      15,
+		order:      44,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
+		symbolType: symbolTypeLower,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ソ': {
+	// "わ"
+	{
 		class:      classKana,
-		order:      15,
+		order:      44,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ぞ': {
+	// "ゐ"
+	{
 		class:      classKana,
-		order:      15,
-		voiced:     voicedVoiced,
+		order:      45,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ゾ': {
+	// "ゑ"
+	{
 		class:      classKana,
-		order:      15,
-		voiced:     voicedVoiced,
+		order:      46,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'た': {
+	// "を"
+	{
 		class:      classKana,
-		order:      16,
+		order:      47,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'タ': {
+	// "ん"
+	{
 		class:      classKana,
-		order:      16,
+		order:      48,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		kanaType:   kanaTypeHiragana,
 	},
-	'だ': {
+	// "ゔ"
+	{
 		class:      classKana,
-		order:      16,
+		order:      3,
 		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeHiragana,
 	},
-	'ダ': {
+	// "ゝ"
+	{
 		class:      classKana,
-		order:      16,
-		voiced:     voicedVoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeKatakana,
+		order:      49,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeRepeat,
+		kanaType:   kanaTypeHiragana,
 	},
-	'ち': {
+	// "ゞ"
+	{
 		class:      classKana,
-		order:      17,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
+		order:      49,
+		voiced:     voicedVoiced,
+		symbolType: symbolTypeRepeat,
 		kanaType:   kanaTypeHiragana,
 	},
-	'チ': {
+	// "ァ"
+	{
 		class:      classKana,
-		order:      17,
+		order:      1,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
+		symbolType: symbolTypeLower,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ぢ': {
+	// "ア"
+	{
 		class:      classKana,
-		order:      17,
-		voiced:     voicedVoiced,
+		order:      1,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ヂ': {
+	// "ィ"
+	{
 		class:      classKana,
-		order:      17,
-		voiced:     voicedVoiced,
-		symbolType: symbolTypeUpper,
+		order:      2,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeLower,
 		kanaType:   kanaTypeKatakana,
 	},
-	'っ': {
+	// "イ"
+	{
 		class:      classKana,
-		order:      18,
+		order:      2,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeHiragana,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ッ': {
+	// "ゥ"
+	{
 		class:      classKana,
-		order:      18,
+		order:      3,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeLower,
 		kanaType:   kanaTypeKatakana,
 	},
-	'つ': {
+	// "ウ"
+	{
 		class:      classKana,
-		order:      18,
+		order:      3,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ツ': {
+	// "ェ"
+	{
 		class:      classKana,
-		order:      18,
+		order:      4,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
+		symbolType: symbolTypeLower,
 		kanaType:   kanaTypeKatakana,
 	},
-	'づ': {
+	// "エ"
+	{
 		class:      classKana,
-		order:      18,
-		voiced:     voicedVoiced,
+		order:      4,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ヅ': {
+	// "ォ"
+	{
 		class:      classKana,
-		order:      18,
-		voiced:     voicedVoiced,
-		symbolType: symbolTypeUpper,
+		order:      5,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeLower,
 		kanaType:   kanaTypeKatakana,
 	},
-	'て': {
+	// "オ"
+	{
 		class:      classKana,
-		order:      19,
+		order:      5,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'テ': {
+	// "カ"
+	{
 		class:      classKana,
-		order:      19,
+		order:      6,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'で': {
+	// "ガ"
+	{
 		class:      classKana,
-		order:      19,
+		order:      6,
 		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'デ': {
+	// "キ"
+	{
 		class:      classKana,
-		order:      19,
-		voiced:     voicedVoiced,
+		order:      7,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'と': {
+	// "ギ"
+	{
 		class:      classKana,
-		order:      20,
-		voiced:     voicedUnvoiced,
+		order:      7,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ト': {
+	// "ク"
+	{
 		class:      classKana,
-		order:      20,
+		order:      8,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ど': {
+	// "グ"
+	{
 		class:      classKana,
-		order:      20,
+		order:      8,
 		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ド': {
+	// "ケ"
+	{
 		class:      classKana,
-		order:      20,
-		voiced:     voicedVoiced,
+		order:      9,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'な': {
+	// "ゲ"
+	{
 		class:      classKana,
-		order:      21,
-		voiced:     voicedUnvoiced,
+		order:      9,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ナ': {
+	// "コ"
+	{
 		class:      classKana,
-		order:      21,
+		order:      10,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'に': {
+	// "ゴ"
+	{
 		class:      classKana,
-		order:      22,
-		voiced:     voicedUnvoiced,
+		order:      10,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ニ': {
+	// "サ"
+	{
 		class:      classKana,
-		order:      22,
+		order:      11,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ぬ': {
+	// "ザ"
+	{
 		class:      classKana,
-		order:      23,
-		voiced:     voicedUnvoiced,
+		order:      11,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ヌ': {
+	// "シ"
+	{
 		class:      classKana,
-		order:      23,
+		order:      12,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ね': {
+	// "ジ"
+	{
 		class:      classKana,
-		order:      24,
-		voiced:     voicedUnvoiced,
+		order:      12,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ネ': {
+	// "ス"
+	{
 		class:      classKana,
-		order:      24,
+		order:      13,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'の': {
+	// "ズ"
+	{
 		class:      classKana,
-		order:      25,
-		voiced:     voicedUnvoiced,
+		order:      13,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ノ': {
+	// "セ"
+	{
 		class:      classKana,
-		order:      25,
+		order:      14,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'は': {
+	// "ゼ"
+	{
 		class:      classKana,
-		order:      26,
-		voiced:     voicedUnvoiced,
+		order:      14,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ハ': {
+	// "ソ"
+	{
 		class:      classKana,
-		order:      26,
+		order:      15,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ば': {
+	// "ゾ"
+	{
 		class:      classKana,
-		order:      26,
+		order:      15,
 		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'バ': {
+	// "タ"
+	{
 		class:      classKana,
-		order:      26,
-		voiced:     voicedVoiced,
+		order:      16,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ぱ': {
+	// "ダ"
+	{
 		class:      classKana,
-		order:      26,
-		voiced:     voicedSemivoiced,
+		order:      16,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'パ': {
+	// "チ"
+	{
 		class:      classKana,
-		order:      26,
-		voiced:     voicedSemivoiced,
+		order:      17,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ひ': {
+	// "ヂ"
+	{
 		class:      classKana,
-		order:      27,
-		voiced:     voicedUnvoiced,
+		order:      17,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ヒ': {
+	// "ッ"
+	{
 		class:      classKana,
-		order:      27,
+		order:      18,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
+		symbolType: symbolTypeLower,
 		kanaType:   kanaTypeKatakana,
 	},
-	'び': {
+	// "ツ"
+	{
 		class:      classKana,
-		order:      27,
-		voiced:     voicedVoiced,
+		order:      18,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ビ': {
+	// "ヅ"
+	{
 		class:      classKana,
-		order:      27,
+		order:      18,
 		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ぴ': {
+	// "テ"
+	{
 		class:      classKana,
-		order:      27,
-		voiced:     voicedSemivoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
-	},
-	'ピ': {
-		class:      classKana,
-		order:      27,
-		voiced:     voicedSemivoiced,
+		order:      19,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ふ': {
+	// "デ"
+	{
 		class:      classKana,
-		order:      28,
-		voiced:     voicedUnvoiced,
+		order:      19,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'フ': {
+	// "ト"
+	{
 		class:      classKana,
-		order:      28,
+		order:      20,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ぶ': {
+	// "ド"
+	{
 		class:      classKana,
-		order:      28,
+		order:      20,
 		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ブ': {
+	// "ナ"
+	{
 		class:      classKana,
-		order:      28,
-		voiced:     voicedVoiced,
+		order:      21,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ぷ': {
+	// "ニ"
+	{
 		class:      classKana,
-		order:      28,
-		voiced:     voicedSemivoiced,
+		order:      22,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'プ': {
+	// "ヌ"
+	{
 		class:      classKana,
-		order:      28,
-		voiced:     voicedSemivoiced,
+		order:      23,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'へ': {
+	// "ネ"
+	{
 		class:      classKana,
-		order:      29,
+		order:      24,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ヘ': {
+	// "ノ"
+	{
 		class:      classKana,
-		order:      29,
+		order:      25,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'べ': {
+	// "ハ"
+	{
 		class:      classKana,
-		order:      29,
-		voiced:     voicedVoiced,
+		order:      26,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ベ': {
+	// "バ"
+	{
 		class:      classKana,
-		order:      29,
+		order:      26,
 		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ぺ': {
-		class:      classKana,
-		order:      29,
-		voiced:     voicedSemivoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
-	},
-	'ペ': {
+	// "パ"
+	{
 		class:      classKana,
-		order:      29,
+		order:      26,
 		voiced:     voicedSemivoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ほ': {
+	// "ヒ"
+	{
 		class:      classKana,
-		order:      30,
+		order:      27,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ホ': {
+	// "ビ"
+	{
 		class:      classKana,
-		order:      30,
-		voiced:     voicedUnvoiced,
+		order:      27,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ぼ': {
+	// "ピ"
+	{
 		class:      classKana,
-		order:      30,
-		voiced:     voicedVoiced,
+		order:      27,
+		voiced:     voicedSemivoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ボ': {
+	// "フ"
+	{
 		class:      classKana,
-		order:      30,
-		voiced:     voicedVoiced,
+		order:      28,
+		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ぽ': {
+	// "ブ"
+	{
 		class:      classKana,
-		order:      30,
-		voiced:     voicedSemivoiced,
+		order:      28,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ポ': {
+	// "プ"
+	{
 		class:      classKana,
-		order:      30,
+		order:      28,
 		voiced:     voicedSemivoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ま': {
+	// "ヘ"
+	{
 		class:      classKana,
-		order:      31,
+		order:      29,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'マ': {
+	// "ベ"
+	{
 		class:      classKana,
-		order:      31,
-		voiced:     voicedUnvoiced,
+		order:      29,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'み': {
+	// "ペ"
+	{
 		class:      classKana,
-		order:      32,
-		voiced:     voicedUnvoiced,
+		order:      29,
+		voiced:     voicedSemivoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ミ': {
+	// "ホ"
+	{
 		class:      classKana,
-		order:      32,
+		order:      30,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'む': {
+	// "ボ"
+	{
 		class:      classKana,
-		order:      33,
-		voiced:     voicedUnvoiced,
+		order:      30,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ム': {
+	// "ポ"
+	{
 		class:      classKana,
-		order:      33,
-		voiced:     voicedUnvoiced,
+		order:      30,
+		voiced:     voicedSemivoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'め': {
+	// "マ"
+	{
 		class:      classKana,
-		order:      34,
+		order:      31,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'メ': {
+	// "ミ"
+	{
 		class:      classKana,
-		order:      34,
+		order:      32,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'も': {
+	// "ム"
+	{
 		class:      classKana,
-		order:      35,
+		order:      33,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'モ': {
+	// "メ"
+	{
 		class:      classKana,
-		order:      35,
+		order:      34,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ゃ': {
+	// "モ"
+	{
 		class:      classKana,
-		order:      36,
+		order:      35,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeHiragana,
+		symbolType: symbolTypeUpper,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ャ': {
+	// "ャ"
+	{
 		class:      classKana,
 		order:      36,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeLower,
 		kanaType:   kanaTypeKatakana,
 	},
-	'や': {
-		class:      classKana,
-		order:      36,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
-	},
-	'ヤ': {
+	// "ヤ"
+	{
 		class:      classKana,
 		order:      36,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ゅ': {
-		class:      classKana,
-		order:      37,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeHiragana,
-	},
-	'ュ': {
+	// "ュ"
+	{
 		class:      classKana,
 		order:      37,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeLower,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ゆ': {
-		class:      classKana,
-		order:      37,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
-	},
-	'ユ': {
+	// "ユ"
+	{
 		class:      classKana,
 		order:      37,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ょ': {
-		class:      classKana,
-		order:      38,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeHiragana,
-	},
-	'ョ': {
+	// "ョ"
+	{
 		class:      classKana,
 		order:      38,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeLower,
 		kanaType:   kanaTypeKatakana,
 	},
-	'よ': {
-		class:      classKana,
-		order:      38,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
-	},
-	'ヨ': {
+	// "ヨ"
+	{
 		class:      classKana,
 		order:      38,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ら': {
-		class:      classKana,
-		order:      39,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
-	},
-	'ラ': {
+	// "ラ"
+	{
 		class:      classKana,
 		order:      39,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'り': {
-		class:      classKana,
-		order:      40,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
-	},
-	'リ': {
+	// "リ"
+	{
 		class:      classKana,
 		order:      40,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'る': {
-		class:      classKana,
-		order:      41,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
-	},
-	'ル': {
+	// "ル"
+	{
 		class:      classKana,
 		order:      41,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'れ': {
-		class:      classKana,
-		order:      42,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
-	},
-	'ろ': {
-		class:      classKana,
-		order:      42,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
-	},
-	'レ': {
+	// "レ"
+	{
 		class:      classKana,
 		order:      42,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ロ': {
+	// "ロ"
+	{
 		class:      classKana,
 		order:      43,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ゎ': {
-		class:      classKana,
-		order:      44,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeHiragana,
-	},
-	'ヮ': {
+	// "ヮ"
+	{
 		class:      classKana,
 		order:      44,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeLower,
 		kanaType:   kanaTypeKatakana,
 	},
-	'わ': {
+	// "ワ"
+	{
 		class:      classKana,
 		order:      44,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ワ': {
+	// "ヰ"
+	{
 		class:      classKana,
-		order:      44,
+		order:      45,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ゐ': {
+	// "ヱ"
+	{
 		class:      classKana,
-		order:      45,
+		order:      46,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ヰ': {
+	// "ヲ"
+	{
 		class:      classKana,
-		order:      45,
+		order:      47,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ゑ': {
+	// "ン"
+	{
 		class:      classKana,
-		order:      46,
+		order:      48,
 		voiced:     voicedUnvoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ヱ': {
+	// "ヴ"
+	{
 		class:      classKana,
-		order:      46,
-		voiced:     voicedUnvoiced,
+		order:      3,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'を': {
+	// "ヷ"
+	{
 		class:      classKana,
-		order:      47,
-		voiced:     voicedUnvoiced,
+		order:      44,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ヲ': {
+	// "ヸ"
+	{
 		class:      classKana,
-		order:      47,
-		voiced:     voicedUnvoiced,
+		order:      45,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ん': {
+	// "ヹ"
+	{
 		class:      classKana,
-		order:      48,
-		voiced:     voicedUnvoiced,
+		order:      46,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
-		kanaType:   kanaTypeHiragana,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ン': {
+	// "ヺ"
+	{
 		class:      classKana,
-		order:      48,
-		voiced:     voicedUnvoiced,
+		order:      47,
+		voiced:     voicedVoiced,
 		symbolType: symbolTypeUpper,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ゝ': {
-		class:      classKana,
-		order:      49,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeHiragana,
+	// "・"
+	{
+		class: classDescriptor,
+		order: 5,
 	},
-	'ヽ': {
+	// "ー"
+	{
 		class:      classKana,
-		order:      49,
+		order:      50,
 		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLower,
+		symbolType: symbolTypeLongVowel,
 		kanaType:   kanaTypeKatakana,
 	},
-	'ゞ': {
+	// "ヽ"
+	{
 		class:      classKana,
 		order:      49,
-		voiced:     voicedVoiced,
-		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeHiragana,
+		voiced:     voicedUnvoiced,
+		symbolType: symbolTypeRepeat,
+		kanaType:   kanaTypeKatakana,
 	},
-	'ヾ': {
+	// "ヾ"
+	{
 		class:      classKana,
 		order:      49,
 		voiced:     voicedVoiced,
-		symbolType: symbolTypeLower,
-		kanaType:   kanaTypeKatakana,
-	},
-	'ー': {
-		class:      classKana,
-		order:      50,
-		voiced:     voicedUnvoiced,
-		symbolType: symbolTypeLongVowel,
+		symbolType: symbolTypeRepeat,
 		kanaType:   kanaTypeKatakana,
 	},
-	'〃': {
-		class: classKanji,
-		order: 1,
-	},
-	'仝': {
+	// "仝"
+	{
 		class: classKanji,
 		order: 2,
 	},
-	'々': {
-		class: classKanji,
+	// "！"
+	{
+		class: classDescriptor,
+		order: 9,
+	},
+	// "（"
+	{
+		class: classBracket,
+		order: 5,
+	},
+	// "）"
+	{
+		class: classBracket,
+		order: 6,
+	},
+	// "＋"
+	{
+		class: classScience,
+		order: 1,
+	},
+	// "，"
+	{
+		class: classDescriptor,
 		order: 3,
 	},
-	'〆': {
-		class: classKanji,
+	// "－"
+	{
+		class: classScience,
+		order: 2,
+	},
+	// "．"
+	{
+		class: classDescriptor,
 		order: 4,
 	},
-	'〇': {
-		class: classKanji,
-		order: 5,
+	// "／"
+	{
+		class: classDescriptor,
+		order: 14,
 	},
-	'〓': {
-		class: classGeta,
-		order: 1,
+	// "："
+	{
+		class: classDescriptor,
+		order: 6,
+	},
+	// "；"
+	{
+		class: classDescriptor,
+		order: 7,
+	},
+	// "＜"
+	{
+		class: classScience,
+		order: 8,
+	},
+	// "＝"
+	{
+		class: classScience,
+		order: 6,
+	},
+	// "＞"
+	{
+		class: classScience,
+		order: 9,
+	},
+	// "？"
+	{
+		class: classDescriptor,
+		order: 8,
+	},
+	// "［"
+	{
+		class: classBracket,
+		order: 9,
+	},
+	// "＼"
+	{
+		class: classDescriptor,
+		order: 15,
+	},
+	// "］"
+	{
+		class: classBracket,
+		order: 10,
+	},
+	// "＿"
+	{
+		class: classDescriptor,
+		order: 11,
+	},
+	// "｛"
+	{
+		class: classBracket,
+		order: 11,
+	},
+	// "｜"
+	{
+		class: classDescriptor,
+		order: 18,
+	},
+	// "｝"
+	{
+		class: classBracket,
+		order: 12,
+	},
+	// "～"
+	{
+		class: classDescriptor,
+		order: 16,
+	},
+	// "￠"
+	{
+		class: classUnit,
+		order: 7,
+	},
+	// "￡"
+	{
+		class: classUnit,
+		order: 8,
+	},
+	// "￢"
+	{
+		class: classScience,
+		order: 37,
+	},
+	// "￣"
+	{
+		class: classDescriptor,
+		order: 10,
 	},
 }
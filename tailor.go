@@ -0,0 +1,133 @@
+package jisx4061
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/shogo82148/jisx4061/internal/table"
+)
+
+// Tailoring is a set of characters that are added to or override the default collation table.
+type Tailoring struct {
+	table map[rune]attr
+	rules []rule
+}
+
+// MaxOrder is the largest 番号 (order) that a tailored collation table can have.
+// Larger orders cannot be encoded in the keys returned by [Collator.Key].
+const MaxOrder = table.MaxOrder
+
+// TableError is an error at a line of a collation table.
+type TableError struct {
+	// Line is the line number, starting from 1.
+	Line int
+
+	// Msg describes the error.
+	Msg string
+}
+
+func (e *TableError) Error() string {
+	return fmt.Sprintf("jisx4061: line %d: %s", e.Line, e.Msg)
+}
+
+// TableErrors is the list of errors in a collation table, sorted by the lines.
+type TableErrors []*TableError
+
+func (list TableErrors) Error() string {
+	switch len(list) {
+	case 0:
+		return "jisx4061: no errors"
+	case 1:
+		return list[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", list[0], len(list)-1)
+}
+
+// ParseTailoring parses a collation table in the same format as table.tsv.
+// The first line is the header, and each of the other lines has the columns
+// 文字, 文字クラス, 番号, ダイアクリティカルマーク, 大小, 清濁, 記号種別 and 仮名種別 separated by tabs.
+// 番号 must be between 1 and [MaxOrder].
+// The characters in the table are added to the default table, or override the attributes in the default table.
+// If the table has errors, it returns [TableErrors] that reports all the errors with their line numbers.
+func ParseTailoring(r io.Reader) (*Tailoring, error) {
+	entries, err := table.Parse(r)
+	if err == nil {
+		err = table.ValidateDuplicates(entries)
+	}
+	if err != nil {
+		var list table.ErrorList
+		if !errors.As(err, &list) {
+			return nil, err
+		}
+		errs := make(TableErrors, 0, len(list))
+		for _, e := range list {
+			errs = append(errs, &TableError{
+				Line: e.Line,
+				Msg:  e.Msg,
+			})
+		}
+		return nil, errs
+	}
+
+	t := &Tailoring{
+		table: make(map[rune]attr, len(entries)),
+	}
+	for _, e := range entries {
+		t.table[e.Rune] = attr{
+			class:           class(e.Class),
+			order:           e.Order,
+			diacriticalMark: diacriticalMark(e.DiacriticalMark),
+			letterCase:      letterCase(e.LetterCase),
+			voiced:          voiced(e.Voiced),
+			symbolType:      symbolType(e.SymbolType),
+			kanaType:        kanaType(e.KanaType),
+		}
+	}
+	return t, nil
+}
+
+// WithTailoring makes the collator use the default collation table merged with t.
+// If it is given more than once, the later tailoring takes precedence.
+// The rules parsed by [ParseRules] are applied to the table tailored by the preceding options,
+// and the kanji in the rules are ordered by the preceding [WithKanjiOrder].
+// The characters put by the rules are merged with the ones put by the preceding rules,
+// and a table tailored later takes precedence over them.
+func WithTailoring(t *Tailoring) Option {
+	return func(c *Collator) {
+		m := make(map[rune]attr, len(c.table)+len(t.table))
+		for r, a := range c.table {
+			m[r] = a
+		}
+		for r, a := range t.table {
+			m[r] = a
+			if c.rules != nil {
+				// the table takes precedence over the preceding rules.
+				c.rules.remove(r)
+			}
+		}
+		c.table = m
+		if len(t.rules) > 0 {
+			c.applyRules(t.rules)
+		}
+	}
+}
+
+// lookup returns the attribute of r in the tailored table or the default table.
+func (c *Collator) lookup(r rune) (attr, bool) {
+	if c.table != nil {
+		if a, ok := c.table[r]; ok {
+			return a, true
+		}
+	}
+	return lookup(r)
+}
+
+// isTailored reports whether r is in the tailored table.
+func (c *Collator) isTailored(r rune) bool {
+	if c.table == nil {
+		return false
+	}
+	_, ok := c.table[r]
+	return ok
+}
@@ -0,0 +1,171 @@
+package jisx4061
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+const testTailoring = "文字\t文字クラス\t番号\tダイアクリティカルマーク\t大小\t清濁\t記号種別\t仮名種別\n" +
+	"㊙\t一般記号\t1000\t\t\t\t\t\n" +
+	"✅\t一般記号\t1001\t\t\t\t\t\n" +
+	"ゟ\t仮名\t51\t\t\t清音\t大文字\t平仮名\n" + // after ん, ゝ and ー
+	"ｱ\t一般記号\t1003\t\t\t\t\t\n" + // half-width katakana are tailored separately
+	"Ω\tラテンアルファベット\t26\t\t大文字\t\t\t\n" // as same as Z
+
+func TestWithTailoring(t *testing.T) {
+	tailoring, err := ParseTailoring(strings.NewReader(testTailoring))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := New(WithTailoring(tailoring))
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"㊙", "", 1},
+		{"㊙", "✅", -1},
+		{"a㊙", "a✅", -1},
+		{"ん", "ゟ", -1},
+		{"ゟ", "ヴ", 1},
+		{"Ω", "z", 1},
+		{"Ω", "Z", 0},
+		{"ｱ", "✅", 1},
+		{"ｱ", "ア", -1},
+		{"ｲ", "イ", 0}, // not tailored
+
+		// the default table is still used.
+		{"あ", "い", -1},
+		{"a", "b", -1},
+	}
+	for _, tt := range tests {
+		if got := c.Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := bytes.Compare(c.Key(tt.a), c.Key(tt.b)); got != tt.want {
+			t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	// the default collator is not changed.
+	if got := Compare("㊙", ""); got != 0 {
+		t.Errorf("Compare(%q, %q) = %d, want 0", "㊙", "", got)
+	}
+	if err := c.Validate("㊙"); err != nil {
+		t.Errorf("Validate(%q) = %v, want nil", "㊙", err)
+	}
+}
+
+func TestWithTailoring_Override(t *testing.T) {
+	first, err := ParseTailoring(strings.NewReader(testTailoring))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := ParseTailoring(strings.NewReader(
+		"header\n" +
+			"a\tラテンアルファベット\t27\t\t小文字\t\t\t\n" + // after z
+			"㊙\t一般記号\t1002\t\t\t\t\t\n",
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := New(WithTailoring(first), WithTailoring(second))
+	if got := c.Compare("a", "z"); got != 1 {
+		t.Errorf("Compare(%q, %q) = %d, want 1", "a", "z", got)
+	}
+	if got := c.Compare("㊙", "✅"); got != 1 {
+		t.Errorf("Compare(%q, %q) = %d, want 1", "㊙", "✅", got)
+	}
+}
+
+func TestParseTailoring_Table(t *testing.T) {
+	// the default table is parsed to the same attributes as the generated table.
+	f, err := os.Open("table.tsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	tailoring, err := ParseTailoring(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tailoring.table) == 0 {
+		t.Fatal("no entries")
+	}
+	for r, a := range tailoring.table {
+		want, ok := lookup(r)
+		if !ok {
+			t.Errorf("%q is not in the generated table", r)
+			continue
+		}
+		if a != want {
+			t.Errorf("%q: got %#v, want %#v", r, a, want)
+		}
+	}
+}
+
+func TestParseTailoring_Error(t *testing.T) {
+	input := "文字\t文字クラス\t番号\tダイアクリティカルマーク\t大小\t清濁\t記号種別\t仮名種別\n" +
+		"㊙\t一般記号\t1000\t\t\t\t\t\n" +
+		"✅\t不明\t1001\t\t\t\t\t\n" +
+		"ゟ\t仮名\tx\t\t\t\t\t\n" +
+		"\t\t\t\t\t\t\t\n" + // empty lines are skipped
+		"ab\t一般記号\t1\t\t\t\t\t\n" +
+		"㊙\t一般記号\t1000\t\t\t\t\t\n" +
+		"Ω\tラテンアルファベット\t26\n"
+	_, err := ParseTailoring(strings.NewReader(input))
+	var errs TableErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("want TableErrors, got %v", err)
+	}
+	want := []int{3, 4, 6, 8}
+	if len(errs) != len(want) {
+		t.Fatalf("want %d errors, got %d: %v", len(want), len(errs), errs)
+	}
+	for i, e := range errs {
+		if e.Line != want[i] {
+			t.Errorf("errs[%d].Line = %d, want %d: %v", i, e.Line, want[i], e)
+		}
+	}
+	if got, want := err.Error(), "jisx4061: line 3: unknown class \"不明\" (and 3 more errors)"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	// duplicates are reported after the syntax errors are fixed.
+	input = "header\n" +
+		"㊙\t一般記号\t1000\t\t\t\t\t\n" +
+		"㊙\t一般記号\t1001\t\t\t\t\t\n"
+	_, err = ParseTailoring(strings.NewReader(input))
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Line != 3 {
+		t.Errorf("want a duplicate error at line 3, got %v", err)
+	}
+}
+
+func TestParseTailoring_MaxOrder(t *testing.T) {
+	input := "header\n" +
+		"㊙\t一般記号\t2147483648\t\t\t\t\t\n" +
+		"✅\t一般記号\t40000\t\t\t\t\t\n"
+	_, err := ParseTailoring(strings.NewReader(input))
+	var errs TableErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Line != 2 {
+		t.Fatalf("want an error at line 2, got %v", err)
+	}
+
+	// the maximum order is still encoded in the key in the same order.
+	input = "header\n" +
+		"㊙\t一般記号\t2147483647\t\t\t\t\t\n" +
+		"✅\t一般記号\t40000\t\t\t\t\t\n"
+	tailoring, err := ParseTailoring(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := New(WithTailoring(tailoring))
+	if got := c.Compare("㊙", "✅"); got != 1 {
+		t.Errorf("Compare(%q, %q) = %d, want 1", "㊙", "✅", got)
+	}
+	if got := bytes.Compare(c.Key("㊙"), c.Key("✅")); got != 1 {
+		t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want 1", "㊙", "✅", got)
+	}
+}
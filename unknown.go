@@ -0,0 +1,62 @@
+package jisx4061
+
+import "fmt"
+
+// UnknownRuneError is the error returned when a string contains a rune that is not in the collation table.
+type UnknownRuneError struct {
+	// Rune is the unknown rune.
+	Rune rune
+
+	// Offset is the byte offset of the rune in the string.
+	Offset int
+}
+
+func (e *UnknownRuneError) Error() string {
+	return fmt.Sprintf("jisx4061: unknown rune %U at offset %d", e.Rune, e.Offset)
+}
+
+// Validate returns an [*UnknownRuneError] if s contains a rune that is not in the collation table.
+func Validate(s string) error {
+	return defaultCollator.Validate(s)
+}
+
+// Validate returns an [*UnknownRuneError] if s contains a rune that is not in the collation table of the collator.
+// It reports unknown runes regardless of the [UnknownRunePolicy].
+func (c *Collator) Validate(s string) error {
+	cc := *c
+	cc.unknownRunes = UnknownRuneCodePoint
+	var last rune
+	for i := 0; i < len(s); {
+		a, r, n := cc.getAttr(s[i:], last)
+		if a.class == classCompat && cc.Validate(compatDecomposition[r]) != nil {
+			// report the compatibility character itself, instead of its decomposition.
+			return &UnknownRuneError{
+				Rune:   r,
+				Offset: i,
+			}
+		}
+		if a.class == classUnknown {
+			return &UnknownRuneError{
+				Rune:   r,
+				Offset: i,
+			}
+		}
+		i += n
+		last = r
+	}
+	return nil
+}
+
+// CompareErr is like [Collator.Compare], but it returns an [*UnknownRuneError]
+// if the policy is [UnknownRuneReject] and a or b contains a rune that is not in the collation table.
+func (c *Collator) CompareErr(a, b string) (int, error) {
+	if c.unknownRunes == UnknownRuneReject {
+		if err := c.Validate(a); err != nil {
+			return 0, err
+		}
+		if err := c.Validate(b); err != nil {
+			return 0, err
+		}
+	}
+	return c.Compare(a, b), nil
+}
@@ -0,0 +1,95 @@
+package jisx4061
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestUnknownRunePolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy UnknownRunePolicy
+		a, b   string
+		want   int
+	}{
+		{"ignore", UnknownRuneIgnore, "a😀", "a", 0},
+		{"ignore", UnknownRuneIgnore, "a😀b", "ab", 0},
+		{"ignore", UnknownRuneIgnore, "😀", "", 0},
+		{"ignore", UnknownRuneIgnore, "😀", "😁", 0},
+		{"last", UnknownRuneLast, "a😀", "a", 1},
+		{"last", UnknownRuneLast, "a😀", "a〓", 1},
+		{"last", UnknownRuneLast, "a😀", "aa", 1},
+		{"last", UnknownRuneLast, "😀", "😁", 0},
+		{"code point", UnknownRuneCodePoint, "a😀", "a", 1},
+		{"code point", UnknownRuneCodePoint, "a😀", "a〓", 1},
+		{"code point", UnknownRuneCodePoint, "😀", "😁", -1},
+		{"code point", UnknownRuneCodePoint, "한", "😀", -1},
+		{"reject", UnknownRuneReject, "a😀b", "ab", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(UnknownRunes(tt.policy))
+			if got := c.Compare(tt.a, tt.b); got != tt.want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+			if got := c.Compare(tt.b, tt.a); got != -tt.want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+			}
+			if got := bytes.Compare(c.Key(tt.a), c.Key(tt.b)); got != tt.want {
+				t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		s      string
+		rune   rune
+		offset int
+	}{
+		{"さとう", 0, -1},
+		{"ｶﾞｯｺｳ", 0, -1},
+		{"漢字", 0, -1},
+		{"さ😀", '😀', 3},
+		{"\x00", 0, 0},
+		{"ab\ncd", '\n', 2},
+	}
+	for _, tt := range tests {
+		err := Validate(tt.s)
+		if tt.offset < 0 {
+			if err != nil {
+				t.Errorf("Validate(%q) returns %v, want nil", tt.s, err)
+			}
+			continue
+		}
+		var e *UnknownRuneError
+		if !errors.As(err, &e) {
+			t.Errorf("Validate(%q) returns %v, want *UnknownRuneError", tt.s, err)
+			continue
+		}
+		if e.Rune != tt.rune || e.Offset != tt.offset {
+			t.Errorf("Validate(%q) returns %U at %d, want %U at %d", tt.s, e.Rune, e.Offset, tt.rune, tt.offset)
+		}
+	}
+}
+
+func TestCollator_CompareErr(t *testing.T) {
+	c := New(UnknownRunes(UnknownRuneReject))
+	if _, err := c.CompareErr("さとう", "さ😀"); err == nil {
+		t.Error("want error, got nil")
+	}
+	got, err := c.CompareErr("さと", "さど")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != -1 {
+		t.Errorf("CompareErr(%q, %q) = %d, want %d", "さと", "さど", got, -1)
+	}
+
+	// the other policies never return errors.
+	if _, err := New().CompareErr("さとう", "さ😀"); err != nil {
+		t.Errorf("want nil, got %v", err)
+	}
+}
@@ -4,6 +4,8 @@
 // The collation method is simple collation (単純照合), where comparisons are made according to basic collation rules (基本照合規則).
 // The Latin alphabet is processed, including macronised (マクロン付き文字) and circumflexed characters (サーカムフレックス付き文字).
 // The extended Kanji character class (拡張漢字クラス) is used for the Kanji character class.
+// Runes that are not in the collation table, such as emoji, are ignored by default.
+// See [UnknownRunePolicy] for other policies.
 //
 // [JIS X 4061]: https://ja.wikipedia.org/wiki/%E6%97%A5%E6%9C%AC%E8%AA%9E%E6%96%87%E5%AD%97%E5%88%97%E7%85%A7%E5%90%88%E9%A0%86%E7%95%AA
 package jisx4061
@@ -29,6 +31,11 @@ const (
 	classKana                        // 仮名
 	classKanji                       // 漢字
 	classGeta                        // げた記号
+	classUnknown                     // 未知の文字
+
+	// classCompat is the class of compatibility characters, such as ㈱ and ㌔.
+	// The iterator replaces them with their decompositions, so they are never compared.
+	classCompat
 )
 
 type voiced int // 清濁
@@ -48,6 +55,12 @@ const (
 	symbolTypeLower     // 小文字
 	symbolTypeRepeat    // 繰返し記号
 	symbolTypeUpper     // 大文字
+
+	// the forms of numbers that are not in the table
+	symbolTypeCircled       // 丸数字
+	symbolTypeParenthesized // 括弧付き数字
+	symbolTypeFullStop      // ピリオド付き数字
+	symbolTypeRoman         // ローマ数字
 )
 
 type kanaType int // 仮名種別
@@ -83,6 +96,38 @@ type attr struct {
 	voiced          voiced
 	symbolType      symbolType
 	kanaType        kanaType
+
+	// sub is the sub-ranks of the characters inserted by the tailoring rules.
+	// nil means all sub-ranks are zero.
+	sub *subRanks
+}
+
+// subRanks are the weights that are compared after the weight of each level.
+type subRanks [Senary + 1]int
+
+// subRank returns the sub-rank of the level.
+func (a attr) subRank(level Strength) int {
+	if a.sub == nil {
+		return 0
+	}
+	return a.sub[level]
+}
+
+// weight returns the weight of the lower level.
+func (a attr) weight(level Strength) int {
+	switch level {
+	case Secondary:
+		return int(a.voiced)
+	case Tertiary:
+		return int(a.symbolType)
+	case Quaternary:
+		return int(a.kanaType)
+	case Quinary:
+		return int(a.diacriticalMark)
+	case Senary:
+		return int(a.letterCase)
+	}
+	panic("jisx4061: unknown level")
 }
 
 var vowelTable = map[rune]rune{
@@ -256,37 +301,118 @@ var vowelTable = map[rune]rune{
 	'ン': 'ん',
 }
 
-func getAttr(s string, last rune) (attr0 attr, r rune, n int) {
+// lookup returns the attribute of r in the table.
+func lookup(r rune) (attr, bool) {
+	if r < 0 || r >= tableMax {
+		return attr{}, false
+	}
+	block := int(tableIndex[r>>tableBlockBits])
+	idx := tableBlocks[block<<tableBlockBits|int(r&(1<<tableBlockBits-1))]
+	if idx == 0 {
+		return attr{}, false
+	}
+	return tableEntries[idx], true
+}
+
+func (c *Collator) getAttr(s string, last rune) (attr0 attr, r rune, n int) {
 	for n < len(s) {
 		var ok bool
 		var m int
 		r, m = utf8.DecodeRuneInString(s[n:])
 		n += m
+		if !c.isTailored(r) {
+			// half-width katakana are compared as full-width ones, unless the tailoring has them.
+			r = toWide(r)
+		}
 		switch r {
 		case 'ー':
-			attr0 = table[r]
+			attr0, _ = c.lookup(r)
 			if v, ok := vowelTable[last]; ok {
-				attr0.order = table[v].order
+				a, _ := c.lookup(v)
+				attr0.order = a.order
 			}
 			return
 		case 'ゝ', 'ゞ', 'ヽ', 'ヾ':
-			attr0 = table[r]
-			if last != 'ゝ' && last != 'ゞ' && last != 'ヽ' && last != 'ヾ' {
-				a := table[last]
+			// 繰返し記号 repeats the last kana.
+			// last is the repeated kana if it is also a repeat mark, so a sequence such as ゝゝ repeats the same kana.
+			attr0, _ = c.lookup(r)
+			if a, ok := c.lookup(last); ok && a.class == classKana && !isKanaRepeatMark(last) {
 				attr0.class = a.class
 				attr0.order = a.order
+				r = last
+			}
+			n += combineVoicedMark(&attr0, s[n:])
+			return
+		case '々', '〻':
+			// 々 repeats the last kanji.
+			attr0, _ = c.lookup('々')
+			if a, ok := c.kanjiAttr(last); ok {
+				attr0 = a
+				attr0.symbolType = symbolTypeRepeat
+				r = last
+			}
+			return
+		case '〱', '〲', '〳', '〴':
+			// くの字点 repeats the last two kana. the caller expands it.
+			// 〳 and 〴 are the upper halves of the vertical forms, and 〵 is the lower half.
+			attr0 = attr{
+				class:      classKana,
+				voiced:     voicedUnvoiced,
+				symbolType: symbolTypeRepeat,
+			}
+			if r == '〲' || r == '〴' {
+				attr0.voiced = voicedVoiced
+			}
+			if r == '〳' || r == '〴' {
+				if next, m := utf8.DecodeRuneInString(s[n:]); next == '〵' {
+					n += m
+				}
 			}
 			return
 		}
-		attr0, ok = table[r]
+		attr0, ok = c.lookup(r)
 		if ok {
+			n += combineVoicedMark(&attr0, s[n:])
+			return
+		}
+
+		// handle number forms that are not in the table, such as ① and Ⅻ.
+		// the caller expands them into numbers.
+		if _, _, ok := numberForm(r); ok {
+			attr0 = attr{
+				class: classNumber,
+			}
 			return
 		}
 
-		// handle CJK Unified Ideographs
-		if 0x4e00 <= r && r <= 0x10000 {
+		// handle kanji that are not in the table
+		if isKanji(r) {
 			attr0 = attr{
 				class: classKanji,
+				order: c.orderOfKanji(r),
+			}
+			return
+		}
+
+		// handle compatibility characters. the caller expands them.
+		if c.foldCompat {
+			if _, ok := compatDecomposition[r]; ok {
+				attr0 = attr{
+					class: classCompat,
+				}
+				return
+			}
+		}
+
+		switch c.unknownRunes {
+		case UnknownRuneLast:
+			attr0 = attr{
+				class: classUnknown,
+			}
+			return
+		case UnknownRuneCodePoint:
+			attr0 = attr{
+				class: classUnknown,
 				order: int(r),
 			}
 			return
@@ -295,116 +421,159 @@ func getAttr(s string, last rune) (attr0 attr, r rune, n int) {
 	return
 }
 
-// Less compares the strings a and b according to JIS X 4061.
-// if a < b it returns -1, if a > b it returns 1, and if a == b it returns 0.
-func Compare(a, b string) int {
-	var n int
-	var i, j int
-	var lastA, lastB rune
-	// log.Printf("checking %s < %s", a, b)
-	// for i < len(a) && j < len(b) {
-	// 	attrA, n := getAttr(a, i)
-	// 	i += n
-	// 	attrB, n := getAttr(b, j)
-	// 	j += n
-
-	// 	log.Printf("%#v", attrA)
-	// 	log.Printf("%#v", attrB)
-	// }
-
-	i, j = 0, 0
-	lastA, lastB = 0, 0
-	for i < len(a) && j < len(b) {
-		var attrA, attrB attr
-		attrA, lastA, n = getAttr(a[i:], lastA)
-		i += n
-		attrB, lastB, n = getAttr(b[j:], lastB)
-		j += n
+// isKanaRepeatMark reports whether r is a repeat mark of kana.
+func isKanaRepeatMark(r rune) bool {
+	switch r {
+	case 'ゝ', 'ゞ', 'ヽ', 'ヾ', '〱', '〲', '〳', '〴', '〵':
+		return true
+	}
+	return false
+}
 
-		if attrA.class != attrB.class {
-			return compare(attrA.class, attrB.class)
-		}
-		if attrA.order != attrB.order {
-			return compare(attrA.order, attrB.order)
-		}
+// isDoubleRepeatMark reports whether r is くの字点, which repeats the last two kana.
+func isDoubleRepeatMark(r rune) bool {
+	switch r {
+	case '〱', '〲', '〳', '〴':
+		return true
 	}
-	if i >= len(a) && j < len(b) {
-		return -1
+	return false
+}
+
+// kanjiAttr returns the attribute of r if r is a kanji.
+func (c *Collator) kanjiAttr(r rune) (attr, bool) {
+	if a, ok := c.lookup(r); ok {
+		return a, a.class == classKanji && r != '々'
 	}
-	if i < len(a) && j >= len(b) {
-		return 1
+	if isKanji(r) {
+		return attr{
+			class: classKanji,
+			order: c.orderOfKanji(r),
+		}, true
 	}
+	return attr{}, false
+}
 
-	i, j = 0, 0
-	lastA, lastB = 0, 0
-	for i < len(a) && j < len(b) {
-		var attrA, attrB attr
-		attrA, lastA, n = getAttr(a[i:], lastA)
-		i += n
-		attrB, lastB, n = getAttr(b[j:], lastB)
-		j += n
-
-		if attrA.voiced != attrB.voiced {
-			return compare(attrA.voiced, attrB.voiced)
-		}
+// combineVoicedMark combines the voiced sound mark (濁点) or the semi-voiced sound mark (半濁点)
+// at the beginning of s into the kana a.
+// Both the combining marks (U+3099 and U+309A) used in NFD and the half-width marks (U+FF9E and U+FF9F) are combined.
+// It returns the number of bytes consumed.
+func combineVoicedMark(a *attr, s string) int {
+	if a.class != classKana || a.voiced != voicedUnvoiced {
+		return 0
 	}
+	r, n := utf8.DecodeRuneInString(s)
+	switch r {
+	case '\u3099', 'ﾞ':
+		a.voiced = voicedVoiced
+		return n
+	case '\u309a', 'ﾟ':
+		a.voiced = voicedSemivoiced
+		return n
+	}
+	return 0
+}
+
+// Compare compares the strings a and b according to JIS X 4061.
+// if a < b it returns -1, if a > b it returns 1, and if a == b it returns 0.
+func Compare(a, b string) int {
+	return defaultCollator.Compare(a, b)
+}
+
+// Compare compares the strings a and b according to JIS X 4061 with the collator's options.
+// if a < b it returns -1, if a > b it returns 1, and if a == b it returns 0.
+func (c *Collator) Compare(a, b string) int {
+	return c.compareIter(c.newIter(a), c.newIter(b)).result
+}
+
+// comparison is the result of comparing two strings with the level and the position that decided it.
+type comparison struct {
+	result int
+
+	// level is the level at which the result was decided.
+	// It is zero if the strings are equal or the result was decided by the tiebreak weights.
+	level Strength
+
+	// tiebreak reports whether the result was decided by the tiebreak weights.
+	tiebreak bool
 
-	i, j = 0, 0
-	lastA, lastB = 0, 0
-	for i < len(a) && j < len(b) {
-		var attrA, attrB attr
-		attrA, lastA, n = getAttr(a[i:], lastA)
-		i += n
-		attrB, lastB, n = getAttr(b[j:], lastB)
-		j += n
-
-		if attrA.symbolType != attrB.symbolType {
-			return compare(attrA.symbolType, attrB.symbolType)
+	// pos is the index of the element or the tiebreak weight that decided the result,
+	// or -1 if the strings are equal.
+	pos int
+}
+
+// compareIter compares the collation elements of itA and itB.
+func (c *Collator) compareIter(itA, itB *iter) comparison {
+	// diffs records the first difference at each lower level,
+	// so that the strings are scanned only once.
+	var diffs, positions [Senary + 1]int
+
+	for i := 0; ; i++ {
+		attrA, okA := itA.next()
+		attrB, okB := itB.next()
+		if !okA && okB {
+			return comparison{result: -1, level: Primary, pos: i}
+		}
+		if okA && !okB {
+			return comparison{result: 1, level: Primary, pos: i}
+		}
+		if !okA && !okB {
+			break
 		}
-	}
 
-	i, j = 0, 0
-	lastA, lastB = 0, 0
-	for i < len(a) && j < len(b) {
-		var attrA, attrB attr
-		attrA, lastA, n = getAttr(a[i:], lastA)
-		i += n
-		attrB, lastB, n = getAttr(b[j:], lastB)
-		j += n
-
-		if attrA.kanaType != attrB.kanaType {
-			return compare(attrA.kanaType, attrB.kanaType)
+		if attrA.class != attrB.class {
+			return comparison{result: compare(attrA.class, attrB.class), level: Primary, pos: i}
+		}
+		if attrA.order != attrB.order {
+			return comparison{result: compare(attrA.order, attrB.order), level: Primary, pos: i}
+		}
+		if attrA.sub != attrB.sub {
+			if d := compare(attrA.subRank(Primary), attrB.subRank(Primary)); d != 0 {
+				return comparison{result: d, level: Primary, pos: i}
+			}
+		}
+		for level := Secondary; level <= Senary; level++ {
+			if diffs[level] == 0 {
+				diffs[level] = compare(attrA.weight(level), attrB.weight(level))
+				if diffs[level] == 0 && attrA.sub != attrB.sub {
+					diffs[level] = compare(attrA.subRank(level), attrB.subRank(level))
+				}
+				positions[level] = i
+			}
 		}
 	}
 
-	i, j = 0, 0
-	lastA, lastB = 0, 0
-	for i < len(a) && j < len(b) {
-		var attrA, attrB attr
-		attrA, lastA, n = getAttr(a[i:], lastA)
-		i += n
-		attrB, lastB, n = getAttr(b[j:], lastB)
-		j += n
-
-		if attrA.diacriticalMark != attrB.diacriticalMark {
-			return compare(attrA.diacriticalMark, attrB.diacriticalMark)
+	for level := Secondary; level <= Senary; level++ {
+		if c.ignores(level) {
+			continue
+		}
+		if diffs[level] != 0 {
+			return comparison{result: diffs[level], level: level, pos: positions[level]}
 		}
 	}
 
-	i, j = 0, 0
-	lastA, lastB = 0, 0
-	for i < len(a) && j < len(b) {
-		var attrA, attrB attr
-		attrA, lastA, n = getAttr(a[i:], lastA)
-		i += n
-		attrB, lastB, n = getAttr(b[j:], lastB)
-		j += n
-
-		if attrA.letterCase != attrB.letterCase {
-			return compare(attrA.letterCase, attrB.letterCase)
+	if c.strength >= Senary && c.hasTiebreak() {
+		a, b := itA.tiebreak, itB.tiebreak
+		for i := 0; i < len(a) || i < len(b); i++ {
+			var d int
+			if i >= len(a) || i >= len(b) {
+				d = compare(len(a), len(b))
+			} else {
+				d = compare(a[i], b[i])
+			}
+			if d != 0 {
+				return comparison{result: d, tiebreak: true, pos: i}
+			}
 		}
 	}
-	return 0
+	return comparison{pos: -1}
+}
+
+// CompareLevel compares the strings a and b according to JIS X 4061 up to the given level.
+// if a < b it returns -1, if a > b it returns 1, and if a == b it returns 0.
+func CompareLevel(a, b string, level Strength) int {
+	c := *defaultCollator
+	c.strength = level
+	return c.Compare(a, b)
 }
 
 func compare[T ~int](a, b T) int {
@@ -419,5 +588,10 @@ func compare[T ~int](a, b T) int {
 
 // Less compares the strings a and b according to JIS X 4061 and returns the result a < b.
 func Less(a, b string) bool {
-	return Compare(a, b) < 0
+	return defaultCollator.Less(a, b)
+}
+
+// Less compares the strings a and b with the collator's options and returns the result a < b.
+func (c *Collator) Less(a, b string) bool {
+	return c.Compare(a, b) < 0
 }
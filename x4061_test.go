@@ -4,52 +4,72 @@ import (
 	"testing"
 )
 
+// lessTests are lists of strings in the ascending order.
+var lessTests = [][]string{
+	{
+		"０", "１", "２", "３", "４", "５", "６", "７", "８", "９",
+	},
+	{
+		"ａ", "ｂ", "ｃ", "ｄ", "ｅ", "ｆ", "ｇ", "ｈ", "ｉ", "ｊ", "ｋ", "ｌ", "ｍ", "ｎ", "ｏ", "ｐ", "ｑ", "ｒ", "ｓ", "ｔ", "ｕ", "ｖ", "ｗ", "ｘ", "ｙ", "ｚ",
+	},
+	{
+		"Ａ", "Ｂ", "Ｃ", "Ｄ", "Ｅ", "Ｆ", "Ｇ", "Ｈ", "Ｉ", "Ｊ", "Ｋ", "Ｌ", "Ｍ", "Ｎ", "Ｏ", "Ｐ", "Ｑ", "Ｒ", "Ｓ", "Ｔ", "Ｕ", "Ｖ", "Ｗ", "Ｘ", "Ｙ", "Ｚ",
+	},
+	{
+		"ａ", "Ａ", "ｚ", "Ｚ",
+	},
+	{
+		"a", "aa",
+	},
+	{
+		"〃", "仝", "々", "〆", "〇", "一", "〓",
+	},
+	{
+		"れ", "レ", "ろ", "ロ", "わ",
+	},
+	{
+		"あぁ", "あゝ", "ああ",
+	},
+	{
+		"いすゞゞ", "いすゞず", "いすずゞ", "いすずず",
+	},
+	{
+		"ぶゝゝ", "ぶゝふ", "ぶふゝ", "ぶふふ",
+	},
+	{
+		"人人", "人々", "人人人", "人人々", "人々人", "人々々",
+	},
+	{
+		"しみ〱", "しみしみ", "しみ〲", "しみじみ",
+	},
+
+	// JIS X 4061-1996 参考2 適合性試験データ
+	{
+		"∞ｒ∞", "∞Ｒ＃", "∞ｔ∞", "＃ｒ∞", "＃Ｒ＃", "＃ｔ％", "＃Ｔ％",
+		"８ｔ∞", "８Ｔ∞", "８ｔ＃", "８Ｔ＃", "８ｔ％", "８Ｔ％",
+		"８ｔ８", "８Ｔ８", "ωｒ∞", "ΩＲ％",
+		"ｒｒ∞", "ｒＲ∞", "Ｒｒ∞", "ＲＲ∞", "ＲＴ％",
+		"ｒｔ８", "ｔｒ∞", "ｔｒ８", "ＴＲ８", "ｔｔ８",
+		"シャーレ", "シャイ", "シヤィ", "シャレ",
+		"ちょこ", "ちよこ", "チョコレート",
+		"てーた", "テータ", "テェタ", "てえた", "でーた", "データ", "デェタ", "でえた",
+		"テータｇ", "テェタＧ",
+		// "てぇたｇ", "てぇたＧ", // I had not understood why they are this order :(
+		"てーたー", "テータァ", "てーたあ", "テェター", "てぇたぁ", "てえたー", "でーたー", "データァ",
+		"でェたァ", "デぇタぁ", "デエタア",
+		"ひゆ", "びゅあ", "ぴゅあ", "びゅあー", "ビュアー", "ぴゅあー", "ピュアー",
+		"ヒュウ", "ヒユウ", "ビュウア",
+		// "びゆーあー", // I had not understood why they are this order :(
+		"ビューアー", "ひゅん", "ぴゅん",
+		"ふーり", "フーリ", "ふぅリ", "ふゥり", "ふゥリ", "フウリ",
+		"ブゥり", "ぶうり", "プウリ", "フーリー", "フゥリー", "ふゥりィ", "フぅりぃ", "フウリー", "ふうりぃ", "ブウリイ",
+		"ぷーりー", "ぷゥりイ", "ぷうりー", "プウリイ",
+		"フヽ", "ふゞ", "ぶゝ", "ぶふ", "ぶフ", "ブふ", "ブフ", "ぶぷ", "ブぷ", "ぷゝ", "ぷヽ", "ぷふ",
+	},
+}
+
 func TestLess(t *testing.T) {
-	tests := [][]string{
-		{
-			"０", "１", "２", "３", "４", "５", "６", "７", "８", "９",
-		},
-		{
-			"ａ", "ｂ", "ｃ", "ｄ", "ｅ", "ｆ", "ｇ", "ｈ", "ｉ", "ｊ", "ｋ", "ｌ", "ｍ", "ｎ", "ｏ", "ｐ", "ｑ", "ｒ", "ｓ", "ｔ", "ｕ", "ｖ", "ｗ", "ｘ", "ｙ", "ｚ",
-		},
-		{
-			"Ａ", "Ｂ", "Ｃ", "Ｄ", "Ｅ", "Ｆ", "Ｇ", "Ｈ", "Ｉ", "Ｊ", "Ｋ", "Ｌ", "Ｍ", "Ｎ", "Ｏ", "Ｐ", "Ｑ", "Ｒ", "Ｓ", "Ｔ", "Ｕ", "Ｖ", "Ｗ", "Ｘ", "Ｙ", "Ｚ",
-		},
-		{
-			"ａ", "Ａ", "ｚ", "Ｚ",
-		},
-		{
-			"a", "aa",
-		},
-		{
-			"〃", "仝", "々", "〆", "〇", "一", "〓",
-		},
-
-		// JIS X 4061-1996 参考2 適合性試験データ
-		{
-			"∞ｒ∞", "∞Ｒ＃", "∞ｔ∞", "＃ｒ∞", "＃Ｒ＃", "＃ｔ％", "＃Ｔ％",
-			"８ｔ∞", "８Ｔ∞", "８ｔ＃", "８Ｔ＃", "８ｔ％", "８Ｔ％",
-			"８ｔ８", "８Ｔ８", "ωｒ∞", "ΩＲ％",
-			"ｒｒ∞", "ｒＲ∞", "Ｒｒ∞", "ＲＲ∞", "ＲＴ％",
-			"ｒｔ８", "ｔｒ∞", "ｔｒ８", "ＴＲ８", "ｔｔ８",
-			"シャーレ", "シャイ", "シヤィ", "シャレ",
-			"ちょこ", "ちよこ", "チョコレート",
-			"てーた", "テータ", "テェタ", "てえた", "でーた", "データ", "デェタ", "でえた",
-			"テータｇ", "テェタＧ",
-			// "てぇたｇ", "てぇたＧ", // I had not understood why they are this order :(
-			"てーたー", "テータァ", "てーたあ", "テェター", "てぇたぁ", "てえたー", "でーたー", "データァ",
-			"でェたァ", "デぇタぁ", "デエタア",
-			"ひゆ", "びゅあ", "ぴゅあ", "びゅあー", "ビュアー", "ぴゅあー", "ピュアー",
-			"ヒュウ", "ヒユウ", "ビュウア",
-			// "びゆーあー", // I had not understood why they are this order :(
-			"ビューアー", "ひゅん", "ぴゅん",
-			"ふーり", "フーリ", "ふぅリ", "ふゥり", "ふゥリ", "フウリ",
-			"ブゥり", "ぶうり", "プウリ", "フーリー", "フゥリー", "ふゥりィ", "フぅりぃ", "フウリー", "ふうりぃ", "ブウリイ",
-			"ぷーりー", "ぷゥりイ", "ぷうりー", "プウリイ",
-			"フヽ", "ふゞ", "ぶゝ", "ぶふ", "ぶフ", "ブふ", "ブフ", "ぶぷ", "ブぷ", "ぷゝ", "ぷヽ", "ぷふ",
-		},
-	}
-	for _, tt := range tests {
+	for _, tt := range lessTests {
 		for i, a := range tt {
 			for j, b := range tt {
 				got := Less(a, b)
@@ -61,3 +81,133 @@ func TestLess(t *testing.T) {
 		}
 	}
 }
+
+func benchmarkCompare(b *testing.B, x, y string) {
+	b.Helper()
+	for i := 0; i < b.N; i++ {
+		Compare(x, y)
+	}
+}
+
+func BenchmarkCompare_Kana(b *testing.B) {
+	benchmarkCompare(b, "ぴゅあーでーたのふぅりぃとびゅあー", "ぴゅあーでーたのふぅりぃとびゅあア")
+}
+
+func BenchmarkCompare_Latin(b *testing.B) {
+	benchmarkCompare(b, "The quick brown fox jumps over the lazy dog", "The quick brown fox jumps over the lazy Dog")
+}
+
+func BenchmarkCompare_Kanji(b *testing.B) {
+	benchmarkCompare(b, "日本語文字列照合順番漢字表記東京都千代田区", "日本語文字列照合順番漢字表記東京都千代田町")
+}
+
+// compareMultiPass is the reference implementation of Collator.Compare.
+// It scans the strings once for each level.
+func compareMultiPass(c *Collator, a, b string) int {
+	itA, itB := c.newIter(a), c.newIter(b)
+	for {
+		attrA, okA := itA.next()
+		attrB, okB := itB.next()
+		if !okA && okB {
+			return -1
+		}
+		if okA && !okB {
+			return 1
+		}
+		if !okA && !okB {
+			break
+		}
+
+		if attrA.class != attrB.class {
+			return compare(attrA.class, attrB.class)
+		}
+		if attrA.order != attrB.order {
+			return compare(attrA.order, attrB.order)
+		}
+		if sa, sb := attrA.subRank(Primary), attrB.subRank(Primary); sa != sb {
+			return compare(sa, sb)
+		}
+	}
+
+	for level := Secondary; level <= Senary; level++ {
+		if c.ignores(level) {
+			continue
+		}
+		itA, itB := c.newIter(a), c.newIter(b)
+		for {
+			attrA, okA := itA.next()
+			attrB, okB := itB.next()
+			if !okA || !okB {
+				break
+			}
+			if wa, wb := attrA.weight(level), attrB.weight(level); wa != wb {
+				return compare(wa, wb)
+			}
+			if sa, sb := attrA.subRank(level), attrB.subRank(level); sa != sb {
+				return compare(sa, sb)
+			}
+		}
+	}
+
+	if c.strength >= Senary && c.hasTiebreak() {
+		return compareSlice(itA.tiebreak, itB.tiebreak)
+	}
+	return 0
+}
+
+var differentialCollators = []*Collator{
+	New(),
+	New(IgnoreCase()),
+	New(IgnoreKanaType()),
+	New(WithStrength(Tertiary)),
+	New(UnknownRunes(UnknownRuneLast)),
+	New(WithYomi(testYomi)),
+	New(Numeric()),
+	New(KanjiNumerals(), WithYomi(testYomi)),
+	New(FoldCompatibility()),
+	New(WithTailoring(testRules)),
+}
+
+func TestCompare_Differential(t *testing.T) {
+	var list []string
+	for _, tt := range lessTests {
+		list = append(list, tt...)
+	}
+	list = append(list, randomStrings(200)...)
+	for _, c := range differentialCollators {
+		for _, a := range list {
+			for _, b := range list {
+				want := compareMultiPass(c, a, b)
+				if got := c.Compare(a, b); got != want {
+					t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+				}
+			}
+		}
+	}
+}
+
+func FuzzCompare(f *testing.F) {
+	for _, tt := range lessTests {
+		for i := 1; i < len(tt); i++ {
+			f.Add(tt[i-1], tt[i])
+		}
+	}
+	f.Fuzz(func(t *testing.T, a, b string) {
+		for _, c := range differentialCollators {
+			want := compareMultiPass(c, a, b)
+			if got := c.Compare(a, b); got != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+			}
+		}
+	})
+}
+
+// compareSlice compares a and b lexicographically.
+func compareSlice[T ~int](a, b []T) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return compare(a[i], b[i])
+		}
+	}
+	return compare(len(a), len(b))
+}
@@ -0,0 +1,95 @@
+package jisx4061
+
+// YomiProvider provides the readings (読み) of kanji.
+type YomiProvider interface {
+	// Yomi returns the reading of s in Hiragana or Katakana.
+	// s is a run of kanji, or a single kanji if the reading of the run is unknown.
+	// It returns false if it doesn't know the reading.
+	Yomi(s string) (yomi string, ok bool)
+}
+
+// YomiProviderFunc is an adapter to allow the use of ordinary functions as [YomiProvider].
+type YomiProviderFunc func(s string) (yomi string, ok bool)
+
+// Yomi implements [YomiProvider].
+func (f YomiProviderFunc) Yomi(s string) (string, bool) {
+	return f(s)
+}
+
+// WithYomi makes the collator use the reading collation (読み照合).
+// Runs of kanji are compared by their readings provided by p, and then by the kanji themselves.
+// Kanji whose readings are unknown are compared as kanji.
+func WithYomi(p YomiProvider) Option {
+	return func(c *Collator) {
+		c.yomi = p
+	}
+}
+
+type kanjiAttr struct {
+	r    rune
+	attr attr
+}
+
+// readKanji reads the run of kanji at the current position,
+// and pushes the attributes of its reading into the buffer.
+func (it *iter) readKanji() {
+	var kanji []kanjiAttr
+	start, last, last2 := it.i, it.last, it.last2
+	for it.i < len(it.s) {
+		a, r, n := it.c.getAttr(it.s[it.i:], it.last)
+		if a.class != classKanji {
+			break
+		}
+		if it.c.kanjiNumerals && isKanjiNumeral(r) {
+			// kanji numerals are compared by their values.
+			break
+		}
+		kanji = append(kanji, kanjiAttr{
+			r:    r,
+			attr: a,
+		})
+		it.i += n
+		it.setLast(r)
+	}
+
+	it.last, it.last2 = last, last2
+	if yomi, ok := it.c.yomi.Yomi(it.s[start:it.i]); ok {
+		it.pushYomi(yomi)
+		for _, k := range kanji {
+			it.tiebreak = append(it.tiebreak, k.attr.order)
+		}
+		return
+	}
+
+	// fallback to the reading of each kanji
+	for _, k := range kanji {
+		if yomi, ok := it.c.yomi.Yomi(string(k.r)); ok {
+			it.pushYomi(yomi)
+			it.tiebreak = append(it.tiebreak, k.attr.order)
+		} else {
+			it.buf = append(it.buf, k.attr)
+			it.setLast(k.r)
+		}
+	}
+}
+
+// pushYomi pushes the attributes of the reading into the buffer.
+func (it *iter) pushYomi(yomi string) {
+	for i := 0; i < len(yomi); {
+		a, r, n := it.c.getAttr(yomi[i:], it.last)
+		i += n
+		if a.class == 0 {
+			break
+		}
+		if a.class == classCompat {
+			it.pushYomi(compatDecomposition[r])
+			continue
+		}
+		if isDoubleRepeatMark(r) {
+			it.pushDoubleRepeat(a)
+			continue
+		}
+		it.setLast(r)
+		it.buf = append(it.buf, a)
+	}
+}
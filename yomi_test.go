@@ -0,0 +1,56 @@
+package jisx4061
+
+import (
+	"bytes"
+	"testing"
+)
+
+var testYomi = YomiProviderFunc(func(s string) (string, bool) {
+	yomi, ok := map[string]string{
+		"東京": "とうきょう",
+		"大阪": "おおさか",
+		"京都": "きょうと",
+		"橋":  "はし",
+		"箸":  "はし",
+		"端":  "はし",
+		"東":  "ひがし",
+		"西":  "にし",
+		"口":  "ぐち",
+	}[s]
+	return yomi, ok
+})
+
+func TestWithYomi(t *testing.T) {
+	tests := [][]string{
+		{"大阪", "京都", "東京"},
+		{"とうきょう", "東京", "トウキョウ", "とうきょうと"},
+		{"はし", "橋", "端", "箸", "はしご"},
+		{"橋", "端", "凪"},
+		{"東京", "西", "西口", "東", "東口"},
+		{"ａ", "東京", "〓"},
+	}
+	c := New(WithYomi(testYomi))
+	for _, tt := range tests {
+		for i, a := range tt {
+			for j, b := range tt {
+				want := compare(i, j)
+				if got := c.Compare(a, b); got != want {
+					t.Errorf("Compare(%q, %q) = %d, want %d", a, b, got, want)
+				}
+				if got := bytes.Compare(c.Key(a), c.Key(b)); got != want {
+					t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", a, b, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestWithYomi_Strength(t *testing.T) {
+	c := New(WithYomi(testYomi), WithStrength(Quinary))
+	if got := c.Compare("東京", "とうきょう"); got != 0 {
+		t.Errorf("Compare(%q, %q) = %d, want 0", "東京", "とうきょう", got)
+	}
+	if got := c.Compare("橋", "箸"); got != 0 {
+		t.Errorf("Compare(%q, %q) = %d, want 0", "橋", "箸", got)
+	}
+}